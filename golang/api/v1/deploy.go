@@ -60,6 +60,7 @@ type DeployImageResponse struct {
 	RequestID *string  `json:"requestId"`
 	ImageName *string  `json:"imageName"`
 	Tag       string   `json:"tag"`
+	Digest    string   `json:"digest"`
 	Logs      []string `json:"logs"`
 }
 
@@ -104,6 +105,11 @@ type Probe struct {
 	DotnetAppSettingsJSON = "dotnet-appsettings"
 )
 
+// DeploymentStrategyBlueGreen starts the new container next to the old one and switches over once it is healthy
+const DeploymentStrategyBlueGreen = "BLUE_GREEN"
+
+// HealthCheckConfig probes are HTTP GET requests, dagent runs them with the curl or wget of the image,
+// images without either count as healthy after the startup grace period
 type HealthCheckConfig struct {
 	Port           uint16 `json:"Port"`
 	LivenessProbe  *Probe `json:"livenessProbe"`
@@ -179,6 +185,9 @@ type ContainerConfig struct {
 	Args []string `json:"args"`
 	// if we need to spawn a pseudo-terminal
 	TTY bool `json:"tty"`
+	// when the image is pulled: Always, IfNotPresent or Never
+	// dagent pulls the missing images and k8s uses its own default if empty
+	PullPolicy builder.PullPolicy `json:"pullPolicy"`
 
 	// dagent only
 	LogConfig     *container.LogConfig      `json:"logConfig"`
@@ -189,11 +198,14 @@ type ContainerConfig struct {
 	Networks []string `json:"networks"`
 	// docker only labels
 	DockerLabels map[string]string `json:"dockerLabels"`
+	// keys of the secrets written to files in /run/secrets instead of env variables
+	SecretFiles []string `json:"secretFiles,omitempty"`
 
-	// k8s-only-section
-	// Deployments strategy, on deployment how to restart underlying pods
-	// Values: Recreate (all-at-once), Rolling(one-by-one only if succeeds)
+	// Deployments strategy, on deployment how to restart underlying pods or containers
+	// Values: Recreate (all-at-once), Rolling(one-by-one only if succeeds), BlueGreen (dagent only)
 	DeploymentStrategy string `json:"deploymentStrategy"`
+
+	// k8s-only-section
 	// health check configuration
 	HealthCheckConfig HealthCheckConfig `json:"healthCheck"`
 	// custom header configuration
@@ -323,7 +335,8 @@ type VolumeLink struct {
 //		"class": ""
 //		}]
 //
-// Note: dagent maps these back into the old "name|/some/path" format, ingoring type and size constraints
+// Note: on dagent tmp and mem are tmpfs mounts with the given size, RO is a read-only host directory,
+// RWO and RWX are host directories or named volumes depending on the agent configuration
 type Volume struct {
 	// name of the volume, the prefix will be the pod using it
 	Name string `json:"name"`
@@ -380,6 +393,8 @@ type UploadFileData struct {
 	UID int `form:"uid"`
 	// GID for the file to be created
 	GID int `form:"gid"`
+	// permissions of the file to be created, 0644 if not set
+	Mode int64 `form:"mode"`
 }
 
 // custom struct unmarshal JSON interface implementation
@@ -7,6 +7,7 @@
 	"github.com/rs/zerolog/log"
 
 	commonConfig "github.com/dyrector-io/dyrectorio/golang/internal/config"
+	"github.com/dyrector-io/dyrectorio/golang/internal/grpc"
 	"github.com/dyrector-io/dyrectorio/golang/internal/util"
 	"github.com/dyrector-io/dyrectorio/golang/internal/version"
 	"github.com/dyrector-io/dyrectorio/golang/pkg/crane"
@@ -68,8 +69,13 @@ func serve(cCtx *cli.Context) error {
 		return err
 	}
 
-	crane.Serve(cfg)
-	return nil
+	err = crane.Serve(cfg)
+	if grpc.IsTerminalError(err) {
+		log.Error().Err(err).Msg("Stopping, the agent was rejected")
+		return cli.Exit(err.Error(), grpc.TerminalExitCode)
+	}
+
+	return err
 }
 
 func initKey(cCtx *cli.Context) error {
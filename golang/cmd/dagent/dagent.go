@@ -5,6 +5,7 @@
 
 	"github.com/rs/zerolog/log"
 
+	"github.com/dyrector-io/dyrectorio/golang/internal/grpc"
 	"github.com/dyrector-io/dyrectorio/golang/internal/util"
 	"github.com/dyrector-io/dyrectorio/golang/pkg/dagent"
 	"github.com/dyrector-io/dyrectorio/golang/pkg/dagent/config"
@@ -23,5 +24,11 @@ func main() {
 	}
 	commonConfig.InjectSecret(string(cfg.SecretPrivateKeyFile), &cfg.CommonConfiguration)
 	log.Info().Msg("Configuration loaded.")
-	dagent.Serve(&cfg)
+	if err := dagent.Serve(&cfg); err != nil {
+		if grpc.IsTerminalError(err) {
+			log.Error().Err(err).Msg("Stopping, the agent was rejected")
+			os.Exit(grpc.TerminalExitCode)
+		}
+		log.Panic().Err(err).Msg("Agent stopped")
+	}
 }
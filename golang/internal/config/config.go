@@ -34,6 +34,30 @@ type CommonConfiguration struct {
 	ImportContainerImage string        `yaml:"importContainerImage"  env:"IMPORT_CONTAINER_IMAGE"  env-default:"rclone/rclone:1.57.0"`
 	IngressRootDomain    string        `yaml:"ingressRootDomain"     env:"INGRESS_ROOT_DOMAIN"     env-default:""`
 	ReadHeaderTimeout    time.Duration `yaml:"readHeaderTimeout"     env:"READ_HEADER_TIMEOUT"     env-default:"15s"`
+	// reconnect delay grows exponentially from the base delay up to the max delay, randomized by the jitter factor
+	GrpcReconnectBaseDelay time.Duration `yaml:"grpcReconnectBaseDelay" env:"GRPC_RECONNECT_BASE_DELAY" env-default:"1s"`
+	GrpcReconnectMaxDelay  time.Duration `yaml:"grpcReconnectMaxDelay"  env:"GRPC_RECONNECT_MAX_DELAY"  env-default:"2m"`
+	GrpcReconnectJitter    float64       `yaml:"grpcReconnectJitter"    env:"GRPC_RECONNECT_JITTER"     env-default:"0.5"`
+	// limits of the commands executed at the same time, streams are the followed logs, exec sessions,
+	// stats and container state watches, they are open until crux closes them
+	CommandConcurrency     int `yaml:"commandConcurrency"     env:"COMMAND_CONCURRENCY"      env-default:"4"`
+	ReadCommandConcurrency int `yaml:"readCommandConcurrency" env:"READ_COMMAND_CONCURRENCY" env-default:"32"`
+	StreamConcurrency      int `yaml:"streamConcurrency"      env:"STREAM_CONCURRENCY"       env-default:"64"`
+	// container state watches send the full list this often, only the changes in between
+	ContainerStateSnapshotInterval time.Duration `yaml:"containerStateSnapshotInterval" env:"CONTAINER_STATE_SNAPSHOT_INTERVAL" env-default:"30s"`
+	// gRPC health checks are served on this address if set, eg. ':8081'
+	HealthCheckAddress string `yaml:"healthCheckAddress" env:"HEALTH_CHECK_ADDRESS" env-default:""`
+	// Prometheus metrics are served on the /metrics path of this address if set, eg. ':9100'
+	MetricsAddress string `yaml:"metricsAddress" env:"METRICS_ADDRESS" env-default:""`
+	// spans are exported to this OTLP gRPC collector if set, eg. 'otel-collector:4317'
+	TracingEndpoint string `yaml:"tracingEndpoint" env:"TRACING_ENDPOINT" env-default:""`
+	TracingInsecure bool   `yaml:"tracingInsecure" env:"TRACING_INSECURE" env-default:"false"`
+	// TLS options of the crux connection, if any of them is set the certificates are not fetched from crux
+	// and there is no plain-text fallback, the pins are base64 encoded SHA-256 hashes of the public keys
+	GrpcCACertFile     string   `yaml:"grpcCACertFile"     env:"GRPC_CA_CERT_FILE"     env-default:""`
+	GrpcClientCertFile string   `yaml:"grpcClientCertFile" env:"GRPC_CLIENT_CERT_FILE" env-default:""`
+	GrpcClientKeyFile  string   `yaml:"grpcClientKeyFile"  env:"GRPC_CLIENT_KEY_FILE"  env-default:""`
+	GrpcPinnedSPKI     []string `yaml:"grpcPinnedSPKI"     env:"GRPC_PINNED_SPKI"      env-default:""`
 	// DefaultRegistry container registry used for container name expansion
 	DefaultRegistry string `yaml:"registry"             env:"DEFAULT_REGISTRY"                 env-default:"index.docker.io"`
 	// GRPC token is set separately, because nested structures are not yet suppported in cleanenv
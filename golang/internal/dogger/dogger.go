@@ -8,6 +8,7 @@
 	"github.com/rs/zerolog/log"
 
 	"github.com/dyrector-io/dyrectorio/golang/internal/config"
+	"github.com/dyrector-io/dyrectorio/golang/internal/metrics"
 	"github.com/dyrector-io/dyrectorio/protobuf/go/agent"
 	"github.com/dyrector-io/dyrectorio/protobuf/go/common"
 )
@@ -19,6 +20,9 @@ type DeploymentLogger struct {
 	logs         []string
 	ctx          context.Context
 	appConfig    *config.CommonConfiguration
+	// the status is written multiple times, the metrics count every deployment once
+	started  bool
+	finished bool
 
 	io.StringWriter
 }
@@ -64,6 +68,8 @@ func (dog *DeploymentLogger) Write(messages ...string) {
 }
 
 func (dog *DeploymentLogger) WriteDeploymentStatus(status common.DeploymentStatus, messages ...string) {
+	dog.countDeploymentStatus(status)
+
 	for i := range messages {
 		log.Info().Str("deployment", dog.deploymentID).Msg(messages[i])
 		dog.logs = append(dog.logs, messages...)
@@ -82,6 +88,32 @@ func (dog *DeploymentLogger) WriteDeploymentStatus(status common.DeploymentStatu
 	}
 }
 
+func (dog *DeploymentLogger) countDeploymentStatus(status common.DeploymentStatus) {
+	switch status {
+	case common.DeploymentStatus_IN_PROGRESS:
+		if !dog.started {
+			dog.started = true
+			metrics.DeploymentsStarted.Inc()
+		}
+	case common.DeploymentStatus_SUCCESSFUL:
+		if !dog.finished {
+			dog.finished = true
+			metrics.DeploymentsSucceeded.Inc()
+		}
+	case common.DeploymentStatus_FAILED, common.DeploymentStatus_DOWNGRADED:
+		if !dog.finished {
+			dog.finished = true
+			metrics.DeploymentsFailed.Inc()
+		}
+	case common.DeploymentStatus_OBSOLETE:
+		if !dog.finished {
+			dog.finished = true
+			metrics.DeploymentsCanceled.Inc()
+		}
+	default:
+	}
+}
+
 func (dog *DeploymentLogger) WriteContainerState(containerState string, messages ...string) {
 	prefix := fmt.Sprintf("%s - %s", dog.requestID, containerState)
 
@@ -0,0 +1,63 @@
+package dogger
+
+import (
+	"github.com/rs/zerolog/log"
+
+	"github.com/dyrector-io/dyrectorio/protobuf/go/agent"
+)
+
+// VolumeOperationLogger reports the progress of a volume backup or restore, the same way
+// the DeploymentLogger reports a deployment
+type VolumeOperationLogger struct {
+	operationID string
+	stream      agent.Agent_VolumeOperationStatusClient
+}
+
+func NewVolumeOperationLogger(operationID string, stream agent.Agent_VolumeOperationStatusClient) *VolumeOperationLogger {
+	return &VolumeOperationLogger{
+		operationID: operationID,
+		stream:      stream,
+	}
+}
+
+// Writes to all available streams: std.out and grpc streams
+func (vol *VolumeOperationLogger) Write(messages ...string) {
+	for i := range messages {
+		log.Info().Str("volumeOperation", vol.operationID).Msg(messages[i])
+	}
+
+	vol.send(&agent.VolumeOperationStatusMessage{Log: messages})
+}
+
+func (vol *VolumeOperationLogger) WriteStatus(status agent.VolumeOperationStatus, messages ...string) {
+	for i := range messages {
+		log.Info().Str("volumeOperation", vol.operationID).Msg(messages[i])
+	}
+
+	vol.send(&agent.VolumeOperationStatusMessage{Status: &status, Log: messages})
+}
+
+// WriteProgress reports the size of the archive processed so far
+func (vol *VolumeOperationLogger) WriteProgress(bytes uint64, messages ...string) {
+	for i := range messages {
+		log.Debug().Str("volumeOperation", vol.operationID).Msg(messages[i])
+	}
+
+	vol.send(&agent.VolumeOperationStatusMessage{Bytes: &bytes, Log: messages})
+}
+
+func (vol *VolumeOperationLogger) WriteString(s string) (int, error) {
+	vol.Write(s)
+
+	return len(s), nil
+}
+
+func (vol *VolumeOperationLogger) send(message *agent.VolumeOperationStatusMessage) {
+	if vol.stream == nil {
+		return
+	}
+
+	if err := vol.stream.Send(message); err != nil {
+		log.Error().Err(err).Stack().Str("volumeOperation", vol.operationID).Msg("Write volume operation status error")
+	}
+}
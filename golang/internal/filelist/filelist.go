@@ -0,0 +1,107 @@
+// Package filelist lists the content of a directory in a container by running a shell script
+// with exec, the script only needs sh and stat, both busybox and coreutils have them.
+package filelist
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/dyrector-io/dyrectorio/protobuf/go/agent"
+)
+
+// prints "size rawmode mtime name" for every entry, the directory is the first argument
+const script = `cd -- "$1" || exit 1
+for f in .* *; do
+	case "$f" in .|..) continue;; esac
+	if [ -e "$f" ] || [ -L "$f" ]; then stat -c '%s %f %Y %n' -- "$f"; fi
+done`
+
+// file type bits of the raw mode
+const (
+	typeMask      = 0o170000
+	typeDirectory = 0o040000
+	typeRegular   = 0o100000
+	typeSymlink   = 0o120000
+	permMask      = 0o7777
+)
+
+// Command is the exec command listing the directory
+func Command(dir string) ([]string, error) {
+	if !path.IsAbs(dir) {
+		return nil, fmt.Errorf("path is not absolute: %s", dir)
+	}
+
+	return []string{"sh", "-c", script, "sh", dir}, nil
+}
+
+// Parse reads the output of the command
+func Parse(output io.Reader) ([]*agent.ContainerFileEntry, error) {
+	entries := []*agent.ContainerFileEntry{}
+
+	scanner := bufio.NewScanner(output)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if line == "" {
+			continue
+		}
+
+		entry, err := parseLine(line)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, entry)
+	}
+
+	return entries, scanner.Err()
+}
+
+func parseLine(line string) (*agent.ContainerFileEntry, error) {
+	// the name may contain spaces
+	fields := strings.SplitN(line, " ", 4)
+	if len(fields) != 4 {
+		return nil, fmt.Errorf("invalid file list line: %q", line)
+	}
+
+	size, err := strconv.ParseUint(fields[0], 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid file size: %w", err)
+	}
+
+	rawMode, err := strconv.ParseUint(fields[1], 16, 32)
+	if err != nil {
+		return nil, fmt.Errorf("invalid file mode: %w", err)
+	}
+
+	modifiedAt, err := strconv.ParseInt(fields[2], 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid file modification time: %w", err)
+	}
+
+	return &agent.ContainerFileEntry{
+		Name:       fields[3],
+		Type:       fileType(rawMode),
+		Size:       size,
+		Mode:       uint32(rawMode & permMask),
+		ModifiedAt: timestamppb.New(time.Unix(modifiedAt, 0)),
+	}, nil
+}
+
+func fileType(rawMode uint64) agent.FileType {
+	switch rawMode & typeMask {
+	case typeDirectory:
+		return agent.FileType_FILE_TYPE_DIRECTORY
+	case typeRegular:
+		return agent.FileType_FILE_TYPE_REGULAR
+	case typeSymlink:
+		return agent.FileType_FILE_TYPE_SYMLINK
+	default:
+		return agent.FileType_FILE_TYPE_OTHER
+	}
+}
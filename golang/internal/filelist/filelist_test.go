@@ -0,0 +1,68 @@
+//go:build unit
+// +build unit
+
+package filelist_test
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dyrector-io/dyrectorio/golang/internal/filelist"
+	"github.com/dyrector-io/dyrectorio/protobuf/go/agent"
+)
+
+func TestParse(t *testing.T) {
+	output := "4096 41ed 1700000000 etc\n12 81a4 1700000001 my file.txt\n7 a1ff 1700000002 link\n0 2190 1700000003 null\n"
+
+	entries, err := filelist.Parse(strings.NewReader(output))
+
+	assert.NoError(t, err)
+	assert.Len(t, entries, 4)
+	assert.Equal(t, "etc", entries[0].Name)
+	assert.Equal(t, agent.FileType_FILE_TYPE_DIRECTORY, entries[0].Type)
+	assert.Equal(t, uint32(0o755), entries[0].Mode)
+	assert.Equal(t, "my file.txt", entries[1].Name)
+	assert.Equal(t, agent.FileType_FILE_TYPE_REGULAR, entries[1].Type)
+	assert.Equal(t, uint64(12), entries[1].Size)
+	assert.Equal(t, int64(1700000001), entries[1].ModifiedAt.GetSeconds())
+	assert.Equal(t, agent.FileType_FILE_TYPE_SYMLINK, entries[2].Type)
+	assert.Equal(t, agent.FileType_FILE_TYPE_OTHER, entries[3].Type)
+}
+
+func TestParseInvalid(t *testing.T) {
+	_, err := filelist.Parse(strings.NewReader("not a stat line\n"))
+
+	assert.Error(t, err)
+}
+
+func TestCommandRejectsRelativePath(t *testing.T) {
+	_, err := filelist.Command("etc")
+
+	assert.Error(t, err)
+}
+
+func TestCommandOutput(t *testing.T) {
+	dir := t.TempDir()
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, ".hidden"), []byte("hidden"), 0o600))
+	assert.NoError(t, os.Mkdir(filepath.Join(dir, "sub dir"), 0o755))
+
+	command, err := filelist.Command(dir)
+	assert.NoError(t, err)
+
+	//nolint:gosec // the command is constant
+	output, err := exec.Command(command[0], command[1:]...).Output()
+	assert.NoError(t, err)
+
+	entries, err := filelist.Parse(strings.NewReader(string(output)))
+	assert.NoError(t, err)
+	assert.Len(t, entries, 2)
+	assert.Equal(t, ".hidden", entries[0].Name)
+	assert.Equal(t, uint32(0o600), entries[0].Mode)
+	assert.Equal(t, "sub dir", entries[1].Name)
+	assert.Equal(t, agent.FileType_FILE_TYPE_DIRECTORY, entries[1].Type)
+}
@@ -0,0 +1,55 @@
+package grpc
+
+import (
+	"context"
+	"sync"
+)
+
+type runningDeployment struct {
+	cancel context.CancelFunc
+}
+
+// deploymentRegistry keeps track of the queued and running deployments, so crux can cancel them by their id
+type deploymentRegistry struct {
+	mutex       sync.Mutex
+	deployments map[string]*runningDeployment
+}
+
+var runningDeployments = &deploymentRegistry{
+	deployments: map[string]*runningDeployment{},
+}
+
+// start registers a deployment, returns its context and the function to call when it is finished.
+// A deployment started again with the same id replaces the previous one in the registry.
+func (registry *deploymentRegistry) start(ctx context.Context, id string) (context.Context, func()) {
+	deployCtx, cancel := context.WithCancel(ctx)
+	deployment := &runningDeployment{cancel: cancel}
+
+	registry.mutex.Lock()
+	registry.deployments[id] = deployment
+	registry.mutex.Unlock()
+
+	return deployCtx, func() {
+		registry.mutex.Lock()
+		if registry.deployments[id] == deployment {
+			delete(registry.deployments, id)
+		}
+		registry.mutex.Unlock()
+
+		cancel()
+	}
+}
+
+// cancel stops the deployment with the given id, returns false if it is neither queued nor running
+func (registry *deploymentRegistry) cancel(id string) bool {
+	registry.mutex.Lock()
+	defer registry.mutex.Unlock()
+
+	deployment, ok := registry.deployments[id]
+	if !ok {
+		return false
+	}
+
+	deployment.cancel()
+	return true
+}
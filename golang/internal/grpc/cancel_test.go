@@ -0,0 +1,76 @@
+//go:build unit
+// +build unit
+
+package grpc_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dyrector-io/dyrectorio/golang/internal/grpc"
+)
+
+func TestCancelDeployment(t *testing.T) {
+	deployCtx, finish := grpc.StartDeploymentForTest(context.Background(), "deployment-1")
+	defer finish()
+
+	assert.NoError(t, grpc.CancelDeploymentForTest("deployment-1"))
+	assert.ErrorIs(t, deployCtx.Err(), context.Canceled)
+}
+
+func TestCancelDeploymentNotRunning(t *testing.T) {
+	_, finish := grpc.StartDeploymentForTest(context.Background(), "deployment-2")
+	finish()
+
+	assert.Error(t, grpc.CancelDeploymentForTest("deployment-2"))
+	assert.Error(t, grpc.CancelDeploymentForTest("unknown"))
+}
+
+func TestCancelDeploymentRestartedWithSameID(t *testing.T) {
+	_, finishFirst := grpc.StartDeploymentForTest(context.Background(), "deployment-3")
+	secondCtx, finishSecond := grpc.StartDeploymentForTest(context.Background(), "deployment-3")
+	defer finishSecond()
+
+	finishFirst()
+
+	assert.NoError(t, grpc.CancelDeploymentForTest("deployment-3"))
+	assert.ErrorIs(t, secondCtx.Err(), context.Canceled)
+}
+
+func TestCancelQueuedDeployment(t *testing.T) {
+	scheduler := grpc.NewCommandSchedulerForTest(testSchedulerConfig())
+	release := make(chan struct{})
+	started := make(chan struct{})
+	go func() {
+		_ = scheduler.RunMutating(context.Background(), "prefix", "api", func() error {
+			close(started)
+			<-release
+			return nil
+		})
+	}()
+	<-started
+	defer close(release)
+
+	// registered when it is received, before it is scheduled
+	deployCtx, finish := grpc.StartDeploymentForTest(context.Background(), "deployment-4")
+	defer finish()
+
+	result := make(chan error, 1)
+	go func() {
+		result <- scheduler.RunMutating(deployCtx, "prefix", "api", func() error {
+			t.Error("canceled deployment was executed")
+			return nil
+		})
+	}()
+
+	assert.NoError(t, grpc.CancelDeploymentForTest("deployment-4"))
+	select {
+	case err := <-result:
+		assert.ErrorIs(t, err, context.Canceled)
+	case <-time.After(time.Second):
+		t.Fatal("canceled deployment was not dropped from the queue")
+	}
+}
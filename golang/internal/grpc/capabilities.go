@@ -0,0 +1,74 @@
+package grpc
+
+import (
+	"context"
+	"runtime"
+
+	"github.com/rs/zerolog/log"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/dyrector-io/dyrectorio/golang/internal/version"
+	"github.com/dyrector-io/dyrectorio/protobuf/go/agent"
+)
+
+// agentCapabilities lists the commands the agent can execute, based on the implemented worker functions
+func agentCapabilities(workerFuncs *WorkerFunctions) []agent.AgentCapability {
+	capabilities := []agent.AgentCapability{}
+	add := func(implemented bool, capability ...agent.AgentCapability) {
+		if implemented {
+			capabilities = append(capabilities, capability...)
+		}
+	}
+
+	add(workerFuncs.Deploy != nil, agent.AgentCapability_CAPABILITY_DEPLOY, agent.AgentCapability_CAPABILITY_CANCEL_DEPLOYMENT)
+	add(workerFuncs.Watch != nil, agent.AgentCapability_CAPABILITY_CONTAINER_STATE)
+	add(workerFuncs.Watch != nil && workerFuncs.ContainerEvents != nil, agent.AgentCapability_CAPABILITY_CONTAINER_STATE_EVENTS)
+	add(workerFuncs.Delete != nil, agent.AgentCapability_CAPABILITY_CONTAINER_DELETE)
+	add(workerFuncs.DeleteContainers != nil, agent.AgentCapability_CAPABILITY_DELETE_CONTAINERS)
+	add(workerFuncs.ContainerCommand != nil, agent.AgentCapability_CAPABILITY_CONTAINER_COMMAND)
+	add(workerFuncs.ContainerLog != nil, agent.AgentCapability_CAPABILITY_CONTAINER_LOG)
+	add(workerFuncs.SecretList != nil, agent.AgentCapability_CAPABILITY_LIST_SECRETS)
+	add(workerFuncs.SelfUpdate != nil, agent.AgentCapability_CAPABILITY_UPDATE)
+	add(workerFuncs.Close != nil, agent.AgentCapability_CAPABILITY_CLOSE)
+	add(workerFuncs.VolumeBackup != nil, agent.AgentCapability_CAPABILITY_VOLUME_BACKUP)
+	add(workerFuncs.VolumeRestore != nil, agent.AgentCapability_CAPABILITY_VOLUME_RESTORE)
+	add(workerFuncs.ContainerExec != nil, agent.AgentCapability_CAPABILITY_CONTAINER_EXEC)
+	add(workerFuncs.ContainerStats != nil, agent.AgentCapability_CAPABILITY_CONTAINER_STATS)
+	add(workerFuncs.ContainerFileList != nil, agent.AgentCapability_CAPABILITY_CONTAINER_FILE_LIST)
+	add(workerFuncs.ContainerFileDownload != nil, agent.AgentCapability_CAPABILITY_CONTAINER_FILE_DOWNLOAD)
+	add(workerFuncs.ContainerFileUpload != nil, agent.AgentCapability_CAPABILITY_CONTAINER_FILE_UPLOAD)
+	add(workerFuncs.ImagePrune != nil, agent.AgentCapability_CAPABILITY_IMAGE_PRUNE)
+	add(workerFuncs.DiskUsage != nil, agent.AgentCapability_CAPABILITY_DISK_USAGE)
+
+	return capabilities
+}
+
+// agentRuntimeInfo collects the details of the host, a failing runtime query is not fatal,
+// the agent connects with the details it has
+func agentRuntimeInfo(ctx context.Context, runtimeInfo RuntimeInfoFunc) *agent.AgentRuntimeInfo {
+	info := &agent.AgentRuntimeInfo{}
+	if runtimeInfo != nil {
+		queried, err := runtimeInfo(ctx)
+		if err != nil {
+			log.Warn().Err(err).Msg("Failed to get the runtime info")
+		}
+		if queried != nil {
+			info = proto.Clone(queried).(*agent.AgentRuntimeInfo)
+		}
+	}
+
+	info.Os = runtime.GOOS
+	info.Arch = runtime.GOARCH
+
+	return info
+}
+
+func newAgentInfo(ctx context.Context, connParams *ConnectionParams, workerFuncs *WorkerFunctions, publicKey string) *agent.AgentInfo {
+	return &agent.AgentInfo{
+		Id:           connParams.nodeID,
+		Version:      version.BuildVersion(),
+		PublicKey:    publicKey,
+		Runtime:      agentRuntimeInfo(ctx, workerFuncs.RuntimeInfo),
+		Capabilities: agentCapabilities(workerFuncs),
+	}
+}
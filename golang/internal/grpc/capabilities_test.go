@@ -0,0 +1,53 @@
+//go:build unit
+// +build unit
+
+package grpc_test
+
+import (
+	"context"
+	"errors"
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dyrector-io/dyrectorio/golang/internal/grpc"
+	"github.com/dyrector-io/dyrectorio/protobuf/go/agent"
+	"github.com/dyrector-io/dyrectorio/protobuf/go/common"
+)
+
+func TestAgentCapabilities(t *testing.T) {
+	capabilities := grpc.AgentCapabilitiesForTest(&grpc.WorkerFunctions{
+		Watch:        func(context.Context, string) []*common.ContainerStateItem { return nil },
+		ContainerLog: func(context.Context, *agent.ContainerLogRequest) (*grpc.ContainerLogContext, error) { return nil, nil },
+	})
+
+	assert.Equal(t, []agent.AgentCapability{
+		agent.AgentCapability_CAPABILITY_CONTAINER_STATE,
+		agent.AgentCapability_CAPABILITY_CONTAINER_LOG,
+	}, capabilities)
+}
+
+func TestAgentCapabilitiesEmpty(t *testing.T) {
+	assert.Empty(t, grpc.AgentCapabilitiesForTest(&grpc.WorkerFunctions{}))
+}
+
+func TestAgentRuntimeInfo(t *testing.T) {
+	kubernetesVersion := "v1.25.4"
+	info := grpc.AgentRuntimeInfoForTest(context.Background(), func(context.Context) (*agent.AgentRuntimeInfo, error) {
+		return &agent.AgentRuntimeInfo{KubernetesVersion: &kubernetesVersion}, nil
+	})
+
+	assert.Equal(t, runtime.GOOS, info.Os)
+	assert.Equal(t, runtime.GOARCH, info.Arch)
+	assert.Equal(t, kubernetesVersion, info.GetKubernetesVersion())
+}
+
+func TestAgentRuntimeInfoError(t *testing.T) {
+	info := grpc.AgentRuntimeInfoForTest(context.Background(), func(context.Context) (*agent.AgentRuntimeInfo, error) {
+		return nil, errors.New("cluster is unreachable")
+	})
+
+	assert.Equal(t, runtime.GOOS, info.Os)
+	assert.Nil(t, info.KubernetesVersion)
+}
@@ -0,0 +1,47 @@
+package grpc
+
+import (
+	"sort"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/dyrector-io/dyrectorio/protobuf/go/common"
+)
+
+// containerStateCache holds the container states last sent to crux, keyed by prefix and name
+type containerStateCache map[string]*common.ContainerStateItem
+
+func containerStateKey(item *common.ContainerStateItem) string {
+	return item.GetId().GetPrefix() + "/" + item.GetId().GetName()
+}
+
+// update stores the current container list, returns the added and changed items and the ids of the removed ones
+func (cache containerStateCache) update(current []*common.ContainerStateItem) (
+	changed []*common.ContainerStateItem, removed []*common.ContainerIdentifier,
+) {
+	seen := map[string]bool{}
+	for _, item := range current {
+		key := containerStateKey(item)
+		seen[key] = true
+
+		if previous, ok := cache[key]; !ok || !proto.Equal(previous, item) {
+			changed = append(changed, item)
+			cache[key] = item
+		}
+	}
+
+	removedKeys := []string{}
+	for key := range cache {
+		if !seen[key] {
+			removedKeys = append(removedKeys, key)
+		}
+	}
+	sort.Strings(removedKeys)
+
+	for _, key := range removedKeys {
+		removed = append(removed, cache[key].GetId())
+		delete(cache, key)
+	}
+
+	return changed, removed
+}
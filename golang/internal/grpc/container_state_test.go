@@ -0,0 +1,161 @@
+//go:build unit
+// +build unit
+
+package grpc_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	grpclib "google.golang.org/grpc"
+
+	"github.com/dyrector-io/dyrectorio/golang/internal/grpc"
+	"github.com/dyrector-io/dyrectorio/protobuf/go/common"
+)
+
+type fakeContainerStateStream struct {
+	grpclib.ClientStream
+	messages chan *common.ContainerStateListMessage
+}
+
+func (stream *fakeContainerStateStream) Send(message *common.ContainerStateListMessage) error {
+	stream.messages <- message
+	return nil
+}
+
+func (stream *fakeContainerStateStream) CloseAndRecv() (*common.Empty, error) {
+	return &common.Empty{}, nil
+}
+
+type fakeContainerList struct {
+	mutex sync.Mutex
+	items []*common.ContainerStateItem
+}
+
+func (list *fakeContainerList) set(items ...*common.ContainerStateItem) {
+	list.mutex.Lock()
+	defer list.mutex.Unlock()
+	list.items = items
+}
+
+func (list *fakeContainerList) get(context.Context, string) []*common.ContainerStateItem {
+	list.mutex.Lock()
+	defer list.mutex.Unlock()
+	return list.items
+}
+
+func testContainerState(name string, state common.ContainerState) *common.ContainerStateItem {
+	return &common.ContainerStateItem{
+		Id:    &common.ContainerIdentifier{Prefix: "prefix", Name: name},
+		State: state,
+	}
+}
+
+func receiveContainerState(t *testing.T, stream *fakeContainerStateStream) *common.ContainerStateListMessage {
+	select {
+	case message := <-stream.messages:
+		return message
+	case <-time.After(time.Second):
+		t.Fatal("no container state message")
+		return nil
+	}
+}
+
+func TestWatchContainerStatusSendsChanges(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	stream := &fakeContainerStateStream{messages: make(chan *common.ContainerStateListMessage, 10)}
+	events := make(chan struct{})
+	list := &fakeContainerList{}
+	list.set(testContainerState("a", common.ContainerState_RUNNING))
+
+	eventsFn := func(context.Context, string) (<-chan struct{}, error) {
+		return events, nil
+	}
+
+	done := make(chan error)
+	go func() {
+		done <- grpc.WatchContainerStatusForTest(ctx, stream, "prefix", true,
+			list.get, eventsFn, time.Hour)
+	}()
+
+	snapshot := receiveContainerState(t, stream)
+	assert.False(t, snapshot.Partial)
+	assert.Len(t, snapshot.Data, 1)
+
+	list.set(testContainerState("a", common.ContainerState_EXITED), testContainerState("b", common.ContainerState_RUNNING))
+	events <- struct{}{}
+
+	changes := receiveContainerState(t, stream)
+	assert.True(t, changes.Partial)
+	assert.Len(t, changes.Data, 2)
+	assert.Empty(t, changes.Removed)
+
+	// nothing changed, nothing is sent
+	events <- struct{}{}
+
+	list.set(testContainerState("b", common.ContainerState_RUNNING))
+	events <- struct{}{}
+
+	changes = receiveContainerState(t, stream)
+	assert.True(t, changes.Partial)
+	assert.Empty(t, changes.Data)
+	assert.Len(t, changes.Removed, 1)
+	assert.Equal(t, "a", changes.Removed[0].Name)
+
+	cancel()
+	assert.NoError(t, <-done)
+}
+
+func TestWatchContainerStatusSendsFullListWithoutPartial(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	stream := &fakeContainerStateStream{messages: make(chan *common.ContainerStateListMessage, 10)}
+	events := make(chan struct{})
+	list := &fakeContainerList{}
+	list.set(testContainerState("a", common.ContainerState_RUNNING), testContainerState("b", common.ContainerState_RUNNING))
+
+	eventsFn := func(context.Context, string) (<-chan struct{}, error) {
+		return events, nil
+	}
+
+	done := make(chan error)
+	go func() {
+		done <- grpc.WatchContainerStatusForTest(ctx, stream, "prefix", false,
+			list.get, eventsFn, time.Hour)
+	}()
+
+	snapshot := receiveContainerState(t, stream)
+	assert.Len(t, snapshot.Data, 2)
+
+	list.set(testContainerState("a", common.ContainerState_EXITED), testContainerState("b", common.ContainerState_RUNNING))
+	events <- struct{}{}
+
+	// the unchanged container is sent too
+	changes := receiveContainerState(t, stream)
+	assert.False(t, changes.Partial)
+	assert.Len(t, changes.Data, 2)
+	assert.Empty(t, changes.Removed)
+
+	cancel()
+	assert.NoError(t, <-done)
+}
+
+func TestWatchContainerStatusSendsSnapshots(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	stream := &fakeContainerStateStream{messages: make(chan *common.ContainerStateListMessage, 10)}
+	list := &fakeContainerList{}
+	list.set(testContainerState("a", common.ContainerState_RUNNING))
+
+	go func() {
+		_ = grpc.WatchContainerStatusForTest(ctx, stream, "prefix", true, list.get, nil, 10*time.Millisecond)
+	}()
+
+	for i := 0; i < 3; i++ {
+		snapshot := receiveContainerState(t, stream)
+		assert.False(t, snapshot.Partial)
+		assert.Len(t, snapshot.Data, 1)
+	}
+}
@@ -0,0 +1,64 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/dyrector-io/dyrectorio/protobuf/go/agent"
+)
+
+func executeImagePrune(ctx context.Context, req *agent.ImagePruneRequest, pruneFn ImagePruneFunc) error {
+	if pruneFn == nil {
+		log.Error().Msg("Image prune function not implemented")
+		return errors.New("image prune function not implemented")
+	}
+
+	log.Info().Bool("dryRun", req.GetDryRun()).Msg("Pruning images")
+
+	images, pruneErr := pruneFn(ctx, req)
+
+	response := &agent.ImagePruneResponse{Id: req.GetId(), DryRun: req.GetDryRun(), Images: images}
+	for _, image := range images {
+		response.ReclaimedBytes += image.GetSize()
+	}
+
+	if pruneErr != nil {
+		log.Error().Err(pruneErr).Msg("Failed to prune images")
+		errorMessage := pruneErr.Error()
+		response.Error = &errorMessage
+	}
+
+	_, err := grpcConn.Client.ImagePruneResult(ctx, response)
+	if err != nil {
+		return fmt.Errorf("failed to send the image prune result: %w", err)
+	}
+
+	return pruneErr
+}
+
+func executeDiskUsage(ctx context.Context, req *agent.DiskUsageRequest, diskUsageFn DiskUsageFunc) error {
+	if diskUsageFn == nil {
+		log.Error().Msg("Disk usage function not implemented")
+		return errors.New("disk usage function not implemented")
+	}
+
+	log.Debug().Str("prefix", req.GetPrefix()).Msg("Getting disk usage")
+
+	response, usageErr := diskUsageFn(ctx, req)
+	if usageErr != nil {
+		log.Error().Err(usageErr).Msg("Failed to get disk usage")
+		errorMessage := usageErr.Error()
+		response = &agent.DiskUsageResponse{Error: &errorMessage}
+	}
+	response.Id = req.GetId()
+
+	_, err := grpcConn.Client.DiskUsage(ctx, response)
+	if err != nil {
+		return fmt.Errorf("failed to send the disk usage: %w", err)
+	}
+
+	return usageErr
+}
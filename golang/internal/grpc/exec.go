@@ -0,0 +1,175 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/dyrector-io/dyrectorio/golang/internal/tracing"
+	"github.com/dyrector-io/dyrectorio/protobuf/go/agent"
+)
+
+const (
+	// the resize events are dropped if the exec implementation can not keep up
+	terminalSizeBufferSize = 8
+	// time for crux to receive the result before the stream is canceled
+	execCloseTimeout = 10 * time.Second
+)
+
+type TerminalSize struct {
+	Width  uint
+	Height uint
+}
+
+// ContainerExecSession is the terminal of an exec process, the stdin is closed by crux
+// or when the stream ends, the resize channel is closed at the same time
+type ContainerExecSession struct {
+	Stdin  io.Reader
+	Stdout io.Writer
+	Stderr io.Writer
+	Resize <-chan TerminalSize
+}
+
+// execOutputWriter sends the output of the process, stdout and stderr share the stream
+type execOutputWriter struct {
+	lock   *sync.Mutex
+	stream agent.Agent_ContainerExecClient
+	stderr bool
+}
+
+func (writer *execOutputWriter) Write(p []byte) (int, error) {
+	data := make([]byte, len(p))
+	copy(data, p)
+
+	output := &agent.ContainerExecOutput{}
+	if writer.stderr {
+		output.Output = &agent.ContainerExecOutput_Stderr{Stderr: data}
+	} else {
+		output.Output = &agent.ContainerExecOutput_Stdout{Stdout: data}
+	}
+
+	if err := writer.send(output); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+func (writer *execOutputWriter) send(output *agent.ContainerExecOutput) error {
+	writer.lock.Lock()
+	defer writer.lock.Unlock()
+
+	return writer.stream.Send(output)
+}
+
+// receiveExecInput forwards the input of crux until the stream ends
+func receiveExecInput(stream agent.Agent_ContainerExecClient, stdin *io.PipeWriter, resize chan<- TerminalSize) {
+	defer close(resize)
+
+	for {
+		input, err := stream.Recv()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				err = nil
+			}
+			stdin.CloseWithError(err)
+			return
+		}
+
+		switch {
+		case input.GetResize() != nil:
+			size := TerminalSize{Width: uint(input.GetResize().GetWidth()), Height: uint(input.GetResize().GetHeight())}
+			select {
+			case resize <- size:
+			default:
+				log.Debug().Msg("Dropped exec terminal resize")
+			}
+		case input.GetCloseStdin():
+			stdin.Close()
+		case input.GetStdin() != nil:
+			// writes after closing the stdin are ignored
+			if _, err := stdin.Write(input.GetStdin()); err != nil && !errors.Is(err, io.ErrClosedPipe) {
+				log.Debug().Err(err).Msg("Failed to write exec stdin")
+			}
+		}
+	}
+}
+
+func executeContainerExec(ctx context.Context, req *agent.ContainerExecRequest, execFn ContainerExecFunc) (err error) {
+	if execFn == nil {
+		log.Error().Msg("Container exec function not implemented")
+		return errors.New("container exec function not implemented")
+	}
+
+	if req.GetId() == "" {
+		return errors.New("empty request id for container exec")
+	}
+
+	prefix := req.GetContainer().GetPrefix()
+	name := req.GetContainer().GetName()
+
+	ctx, span := tracing.Start(ctx, "container exec", tracing.ContainerKey.String(name))
+	defer func() { tracing.End(span, err) }()
+
+	log.Info().Str("prefix", prefix).Str("name", name).Strs("command", req.GetCommand()).Msg("Starting container exec")
+
+	streamCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	streamCtx = tracing.AppendToOutgoingContext(metadata.AppendToOutgoingContext(streamCtx, "dyo-container-exec-id", req.GetId()))
+	stream, err := grpcConn.Client.ContainerExec(streamCtx, grpc.WaitForReady(true))
+	if err != nil {
+		return fmt.Errorf("failed to open container exec channel: %w", err)
+	}
+
+	stdinReader, stdinWriter := io.Pipe()
+	resize := make(chan TerminalSize, terminalSizeBufferSize)
+	received := make(chan struct{})
+	go func() {
+		defer close(received)
+		receiveExecInput(stream, stdinWriter, resize)
+	}()
+
+	lock := &sync.Mutex{}
+	stdout := &execOutputWriter{lock: lock, stream: stream}
+	session := &ContainerExecSession{
+		Stdin:  stdinReader,
+		Stdout: stdout,
+		Stderr: &execOutputWriter{lock: lock, stream: stream, stderr: true},
+		Resize: resize,
+	}
+
+	// the session ends when crux closes the stream
+	exitCode, err := execFn(stream.Context(), req, session)
+	stdinReader.Close()
+
+	result := &agent.ContainerExecOutput{Output: &agent.ContainerExecOutput_ExitCode{ExitCode: int32(exitCode)}}
+	if err != nil {
+		log.Error().Err(err).Str("prefix", prefix).Str("name", name).Msg("Container exec failed")
+		result.Output = &agent.ContainerExecOutput_Error{Error: err.Error()}
+	} else {
+		log.Info().Str("prefix", prefix).Str("name", name).Int("exitCode", exitCode).Msg("Container exec exited")
+	}
+
+	if sendErr := stdout.send(result); sendErr != nil {
+		log.Warn().Err(sendErr).Str("prefix", prefix).Str("name", name).Msg("Failed to send the container exec result")
+	}
+
+	if closeErr := stream.CloseSend(); closeErr != nil {
+		log.Error().Err(closeErr).Str("prefix", prefix).Str("name", name).Msg("Failed to close container exec stream")
+	}
+
+	select {
+	case <-received:
+	case <-time.After(execCloseTimeout):
+		log.Warn().Str("prefix", prefix).Str("name", name).Msg("Container exec stream was not closed by crux")
+	}
+
+	return err
+}
@@ -0,0 +1,55 @@
+//go:build unit
+// +build unit
+
+package grpc_test
+
+import (
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	grpclib "google.golang.org/grpc"
+
+	"github.com/dyrector-io/dyrectorio/golang/internal/grpc"
+	"github.com/dyrector-io/dyrectorio/protobuf/go/agent"
+)
+
+type fakeContainerExecStream struct {
+	grpclib.ClientStream
+	inputs []*agent.ContainerExecInput
+}
+
+func (stream *fakeContainerExecStream) Send(*agent.ContainerExecOutput) error {
+	return nil
+}
+
+func (stream *fakeContainerExecStream) Recv() (*agent.ContainerExecInput, error) {
+	if len(stream.inputs) == 0 {
+		return nil, io.EOF
+	}
+
+	input := stream.inputs[0]
+	stream.inputs = stream.inputs[1:]
+	return input, nil
+}
+
+func TestReceiveExecInput(t *testing.T) {
+	stream := &fakeContainerExecStream{inputs: []*agent.ContainerExecInput{
+		{Input: &agent.ContainerExecInput_Resize{Resize: &agent.TerminalSize{Width: 120, Height: 40}}},
+		{Input: &agent.ContainerExecInput_Stdin{Stdin: []byte("ls\n")}},
+		{Input: &agent.ContainerExecInput_CloseStdin{CloseStdin: true}},
+		{Input: &agent.ContainerExecInput_Stdin{Stdin: []byte("ignored")}},
+	}}
+	stdinReader, stdinWriter := io.Pipe()
+	resize := make(chan grpc.TerminalSize, 1)
+
+	go grpc.ReceiveExecInputForTest(stream, stdinWriter, resize)
+
+	stdin, err := io.ReadAll(stdinReader)
+	assert.NoError(t, err)
+	assert.Equal(t, "ls\n", string(stdin))
+
+	assert.Equal(t, grpc.TerminalSize{Width: 120, Height: 40}, <-resize)
+	_, open := <-resize
+	assert.False(t, open)
+}
@@ -0,0 +1,131 @@
+package grpc
+
+import (
+	"context"
+	"crypto/tls"
+	"io"
+	"time"
+
+	"github.com/dyrector-io/dyrectorio/golang/internal/config"
+	"github.com/dyrector-io/dyrectorio/protobuf/go/agent"
+	"github.com/dyrector-io/dyrectorio/protobuf/go/common"
+)
+
+func ReconnectDelayForTest(appConfig *config.CommonConfiguration, retries int) time.Duration {
+	return newReconnectPolicy(appConfig).delay(retries)
+}
+
+func AsTerminalErrorForTest(err error) error {
+	if terminalErr := asTerminalError(err); terminalErr != nil {
+		return terminalErr
+	}
+	return nil
+}
+
+func ExecuteContainerCommandForTest(ctx context.Context, command *common.ContainerCommandRequest, fn ContainerCommandFunc) error {
+	return executeContainerCommand(ctx, command, fn)
+}
+
+func StartDeploymentForTest(ctx context.Context, id string) (context.Context, func()) {
+	return runningDeployments.start(ctx, id)
+}
+
+func CancelDeploymentForTest(id string) error {
+	return executeCancelDeployment(&agent.CancelDeploymentRequest{Id: id})
+}
+
+type CommandSchedulerForTest struct {
+	scheduler *commandScheduler
+}
+
+func NewCommandSchedulerForTest(appConfig *config.CommonConfiguration) *CommandSchedulerForTest {
+	return &CommandSchedulerForTest{scheduler: newCommandScheduler(appConfig)}
+}
+
+// RunMutating schedules a mutating command targeting the given container, an empty name targets the whole prefix
+func (s *CommandSchedulerForTest) RunMutating(ctx context.Context, prefix, name string, execute func() error) error {
+	return s.scheduler.run(ctx, &scheduledCommand{
+		name:    "test",
+		kind:    commandKindMutating,
+		targets: []targetKey{{prefix: prefix, name: name}},
+		execute: execute,
+	})
+}
+
+// RunExclusive schedules a mutating command conflicting with every other target
+func (s *CommandSchedulerForTest) RunExclusive(ctx context.Context, execute func() error) error {
+	return s.scheduler.run(ctx, &scheduledCommand{
+		name:    "test",
+		kind:    commandKindMutating,
+		targets: []targetKey{exclusiveTarget},
+		execute: execute,
+	})
+}
+
+func (s *CommandSchedulerForTest) StartPeriodicTasks(ctx context.Context, tasks []PeriodicTask) {
+	s.scheduler.startPeriodicTasks(ctx, tasks)
+}
+
+func (s *CommandSchedulerForTest) RunStream(ctx context.Context, execute func() error) error {
+	return s.scheduler.run(ctx, &scheduledCommand{name: "test", kind: commandKindStream, execute: execute})
+}
+
+func (s *CommandSchedulerForTest) RunRead(ctx context.Context, execute func() error) error {
+	return s.scheduler.run(ctx, &scheduledCommand{name: "test", kind: commandKindRead, execute: execute})
+}
+
+func WatchContainerStatusForTest(ctx context.Context, stream agent.Agent_ContainerStateClient, prefix string, partial bool,
+	listFn WatchFunc, eventsFn ContainerEventsFunc, snapshotInterval time.Duration,
+) error {
+	return watchContainerStatus(ctx, stream, &prefix, partial, listFn, eventsFn, snapshotInterval)
+}
+
+func NewTLSConfigForTest(appConfig *config.CommonConfiguration) (*tls.Config, error) {
+	return newTLSConfig(appConfig)
+}
+
+func ExplicitTLSForTest(appConfig *config.CommonConfiguration) bool {
+	return explicitTLS(appConfig)
+}
+
+func AgentCapabilitiesForTest(workerFuncs *WorkerFunctions) []agent.AgentCapability {
+	return agentCapabilities(workerFuncs)
+}
+
+func AgentRuntimeInfoForTest(ctx context.Context, runtimeInfo RuntimeInfoFunc) *agent.AgentRuntimeInfo {
+	return agentRuntimeInfo(ctx, runtimeInfo)
+}
+
+func ReceiveExecInputForTest(stream agent.Agent_ContainerExecClient, stdin *io.PipeWriter, resize chan<- TerminalSize) {
+	receiveExecInput(stream, stdin, resize)
+}
+
+func StreamContainerStatsForTest(stream agent.Agent_ContainerStatsClient, req *agent.ContainerStatsRequest,
+	statsFn ContainerStatsFunc, interval time.Duration,
+) error {
+	return streamContainerStats(stream, req, statsFn, interval)
+}
+
+func ChunkReceiverForTest(chunks [][]byte) io.Reader {
+	return &chunkReceiver{recv: func() ([]byte, error) {
+		if len(chunks) == 0 {
+			return nil, io.EOF
+		}
+
+		chunk := chunks[0]
+		chunks = chunks[1:]
+		return chunk, nil
+	}}
+}
+
+func SizedReaderForTest(reader io.Reader, size int64) io.Reader {
+	return &sizedReader{reader: reader, remaining: size}
+}
+
+func ChunkSenderForTest(send func(data []byte) error) io.Writer {
+	return &chunkSender{send: send}
+}
+
+func WorseDeploymentStatusForTest(status, other common.DeploymentStatus) common.DeploymentStatus {
+	return worseDeploymentStatus(status, other)
+}
@@ -0,0 +1,155 @@
+package grpc
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/rs/zerolog/log"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/dyrector-io/dyrectorio/protobuf/go/agent"
+)
+
+func containerFileContext(ctx context.Context, id string) context.Context {
+	return metadata.AppendToOutgoingContext(ctx, "dyo-container-file-id", id)
+}
+
+func executeContainerFileList(ctx context.Context, req *agent.ContainerFileListRequest, listFn ContainerFileListFunc) error {
+	if listFn == nil {
+		log.Error().Msg("Container file list function not implemented")
+		return errors.New("container file list function not implemented")
+	}
+
+	prefix := req.GetContainer().GetPrefix()
+	name := req.GetContainer().GetName()
+	log.Debug().Str("prefix", prefix).Str("name", name).Str("path", req.GetPath()).Msg("Listing container files")
+
+	entries, listErr := listFn(ctx, req)
+
+	response := &agent.ContainerFileListResponse{Id: req.GetId(), Entries: entries}
+	if listErr != nil {
+		log.Error().Err(listErr).Str("prefix", prefix).Str("name", name).Msg("Failed to list container files")
+		errorMessage := listErr.Error()
+		response.Error = &errorMessage
+	}
+
+	_, err := grpcConn.Client.ContainerFileList(ctx, response)
+	if err != nil {
+		return fmt.Errorf("failed to send the container file list: %w", err)
+	}
+
+	return listErr
+}
+
+func executeContainerFileDownload(ctx context.Context, req *agent.ContainerFileDownloadRequest,
+	downloadFn ContainerFileDownloadFunc,
+) error {
+	if downloadFn == nil {
+		log.Error().Msg("Container file download function not implemented")
+		return errors.New("container file download function not implemented")
+	}
+
+	prefix := req.GetContainer().GetPrefix()
+	name := req.GetContainer().GetName()
+	log.Info().Str("prefix", prefix).Str("name", name).Str("path", req.GetPath()).Msg("Downloading container files")
+
+	stream, err := grpcConn.Client.ContainerFileDownload(containerFileContext(ctx, req.GetId()), grpc.WaitForReady(true))
+	if err != nil {
+		return fmt.Errorf("failed to open container file download channel: %w", err)
+	}
+
+	archive := bufio.NewWriterSize(&chunkSender{send: func(data []byte) error {
+		return stream.Send(&agent.ContainerFileChunk{Data: data})
+	}}, chunkSize)
+
+	if err = downloadFn(stream.Context(), req, archive); err != nil {
+		// crux discards the incomplete archive
+		if closeErr := stream.CloseSend(); closeErr != nil {
+			log.Warn().Err(closeErr).Str("prefix", prefix).Str("name", name).Msg("Failed to close container file download stream")
+		}
+		return err
+	}
+
+	if err = archive.Flush(); err != nil {
+		return fmt.Errorf("container file download error: %w", err)
+	}
+
+	if _, err = stream.CloseAndRecv(); err != nil {
+		return fmt.Errorf("container file download error: %w", err)
+	}
+
+	return nil
+}
+
+func executeContainerFileUpload(ctx context.Context, req *agent.ContainerFileUploadRequest, uploadFn ContainerFileUploadFunc) error {
+	if uploadFn == nil {
+		log.Error().Msg("Container file upload function not implemented")
+		return errors.New("container file upload function not implemented")
+	}
+
+	if req.GetSize() < 0 {
+		return fmt.Errorf("invalid file size: %d", req.GetSize())
+	}
+
+	prefix := req.GetContainer().GetPrefix()
+	name := req.GetContainer().GetName()
+	log.Info().Str("prefix", prefix).Str("name", name).Str("path", req.GetPath()).Str("fileName", req.GetFileName()).
+		Int64("size", req.GetSize()).Msg("Uploading container file")
+
+	stream, err := grpcConn.Client.ContainerFileUpload(containerFileContext(ctx, req.GetId()),
+		&agent.ContainerFileRequest{Id: req.GetId()}, grpc.WaitForReady(true))
+	if err != nil {
+		return fmt.Errorf("failed to open container file upload channel: %w", err)
+	}
+
+	content := &sizedReader{reader: &chunkReceiver{recv: func() ([]byte, error) {
+		chunk, err := stream.Recv()
+		return chunk.GetData(), err
+	}}, remaining: req.GetSize()}
+
+	return uploadFn(stream.Context(), req, content)
+}
+
+var errUploadTooLarge = errors.New("the uploaded file is larger than its size")
+
+// sizedReader ends with an error unless the content is exactly the announced size,
+// the upload is streamed into a tar archive, which has the size in the header before the content
+type sizedReader struct {
+	reader    io.Reader
+	remaining int64
+}
+
+func (sized *sizedReader) Read(p []byte) (int, error) {
+	if sized.remaining == 0 {
+		var extra [1]byte
+		n, err := sized.reader.Read(extra[:])
+		if n > 0 {
+			return 0, errUploadTooLarge
+		}
+		if err == nil {
+			// nothing read yet, the next read decides
+			return 0, nil
+		}
+		return 0, err
+	}
+
+	if int64(len(p)) > sized.remaining {
+		p = p[:sized.remaining]
+	}
+
+	n, err := sized.reader.Read(p)
+	sized.remaining -= int64(n)
+	if errors.Is(err, io.EOF) {
+		if sized.remaining > 0 {
+			return n, io.ErrUnexpectedEOF
+		}
+		// the next read checks that nothing follows
+		err = nil
+	}
+
+	return n, err
+}
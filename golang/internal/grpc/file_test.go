@@ -0,0 +1,56 @@
+//go:build unit
+// +build unit
+
+package grpc_test
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dyrector-io/dyrectorio/golang/internal/grpc"
+)
+
+func TestChunkReceiverJoinsChunks(t *testing.T) {
+	reader := grpc.ChunkReceiverForTest([][]byte{[]byte("hello "), {}, []byte("world")})
+
+	content, err := io.ReadAll(reader)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "hello world", string(content))
+}
+
+func TestChunkSenderCopiesTheBuffer(t *testing.T) {
+	chunks := [][]byte{}
+	writer := grpc.ChunkSenderForTest(func(data []byte) error {
+		chunks = append(chunks, data)
+		return nil
+	})
+
+	buffer := []byte("first")
+	_, err := writer.Write(buffer)
+	assert.NoError(t, err)
+	copy(buffer, "reuse")
+
+	assert.Equal(t, [][]byte{[]byte("first")}, chunks)
+	assert.False(t, bytes.Equal(buffer, chunks[0]))
+}
+
+func TestSizedReader(t *testing.T) {
+	content, err := io.ReadAll(grpc.SizedReaderForTest(strings.NewReader("hello"), 5))
+	assert.NoError(t, err)
+	assert.Equal(t, "hello", string(content))
+
+	_, err = io.ReadAll(grpc.SizedReaderForTest(strings.NewReader("hello world"), 5))
+	assert.ErrorContains(t, err, "larger than its size")
+
+	_, err = io.ReadAll(grpc.SizedReaderForTest(strings.NewReader("hel"), 5))
+	assert.ErrorIs(t, err, io.ErrUnexpectedEOF)
+
+	content, err = io.ReadAll(grpc.SizedReaderForTest(strings.NewReader(""), 0))
+	assert.NoError(t, err)
+	assert.Empty(t, content)
+}
@@ -12,21 +12,22 @@
 	"time"
 
 	"github.com/rs/zerolog/log"
+	"go.opentelemetry.io/otel/trace"
 
 	v1 "github.com/dyrector-io/dyrectorio/golang/api/v1"
 	"github.com/dyrector-io/dyrectorio/golang/internal/config"
 	"github.com/dyrector-io/dyrectorio/golang/internal/dogger"
 	"github.com/dyrector-io/dyrectorio/golang/internal/mapper"
-	"github.com/dyrector-io/dyrectorio/golang/internal/version"
+	"github.com/dyrector-io/dyrectorio/golang/internal/metrics"
+	"github.com/dyrector-io/dyrectorio/golang/internal/tracing"
 	"github.com/dyrector-io/dyrectorio/protobuf/go/agent"
 	"github.com/dyrector-io/dyrectorio/protobuf/go/common"
 
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/connectivity"
-	"google.golang.org/grpc/credentials"
-	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/keepalive"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/types/known/durationpb"
 )
 
 type Connection struct {
@@ -58,6 +59,7 @@ type ContainerLogContext struct {
 type (
 	DeployFunc           func(context.Context, *dogger.DeploymentLogger, *v1.DeployImageRequest, *v1.VersionData) error
 	WatchFunc            func(context.Context, string) []*common.ContainerStateItem
+	ContainerEventsFunc  func(context.Context, string) (<-chan struct{}, error)
 	DeleteFunc           func(context.Context, string, string) error
 	SecretListFunc       func(context.Context, string, string) ([]string, error)
 	SelfUpdateFunc       func(context.Context, string, int32) error
@@ -65,11 +67,27 @@ type ContainerLogContext struct {
 	ContainerCommandFunc func(context.Context, *common.ContainerCommandRequest) error
 	DeleteContainersFunc func(context.Context, *common.DeleteContainersRequest) error
 	ContainerLogFunc     func(context.Context, *agent.ContainerLogRequest) (*ContainerLogContext, error)
+	RuntimeInfoFunc      func(context.Context) (*agent.AgentRuntimeInfo, error)
+	// the archive is nil if the request has an rclone remote
+	VolumeBackupFunc  func(context.Context, *dogger.VolumeOperationLogger, *agent.VolumeBackupRequest, io.Writer) error
+	VolumeRestoreFunc func(context.Context, *dogger.VolumeOperationLogger, *agent.VolumeRestoreRequest, io.Reader) error
+	// returns the exit code of the process
+	ContainerExecFunc func(context.Context, *agent.ContainerExecRequest, *ContainerExecSession) (int, error)
+	// returns one sample of the requested containers
+	ContainerStatsFunc func(context.Context, *agent.ContainerStatsRequest) ([]*agent.ContainerStats, error)
+	// the downloaded file or directory is written as a tar archive, the uploaded file is read until EOF
+	ContainerFileListFunc     func(context.Context, *agent.ContainerFileListRequest) ([]*agent.ContainerFileEntry, error)
+	ContainerFileDownloadFunc func(context.Context, *agent.ContainerFileDownloadRequest, io.Writer) error
+	ContainerFileUploadFunc   func(context.Context, *agent.ContainerFileUploadRequest, io.Reader) error
+	// returns the removed images, or the ones to be removed in a dry run
+	ImagePruneFunc func(context.Context, *agent.ImagePruneRequest) ([]*agent.PrunedImage, error)
+	DiskUsageFunc  func(context.Context, *agent.DiskUsageRequest) (*agent.DiskUsageResponse, error)
 )
 
 type WorkerFunctions struct {
 	Deploy           DeployFunc
 	Watch            WatchFunc
+	ContainerEvents  ContainerEventsFunc
 	Delete           DeleteFunc
 	SecretList       SecretListFunc
 	SelfUpdate       SelfUpdateFunc
@@ -77,6 +95,20 @@ type WorkerFunctions struct {
 	ContainerCommand ContainerCommandFunc
 	DeleteContainers DeleteContainersFunc
 	ContainerLog     ContainerLogFunc
+	VolumeBackup     VolumeBackupFunc
+	VolumeRestore    VolumeRestoreFunc
+	ContainerExec    ContainerExecFunc
+	ContainerStats   ContainerStatsFunc
+	// container file browser
+	ContainerFileList     ContainerFileListFunc
+	ContainerFileDownload ContainerFileDownloadFunc
+	ContainerFileUpload   ContainerFileUploadFunc
+	ImagePrune            ImagePruneFunc
+	DiskUsage             DiskUsageFunc
+	// RuntimeInfo is not a command, it describes the host in the AgentInfo
+	RuntimeInfo RuntimeInfoFunc
+	// PeriodicTasks are not commands either, they run through the scheduler while the agent is running
+	PeriodicTasks []PeriodicTask
 }
 
 type contextKey int
@@ -142,7 +174,7 @@ func Init(grpcContext context.Context,
 	connParams *ConnectionParams,
 	appConfig *config.CommonConfiguration,
 	workerFuncs WorkerFunctions,
-) {
+) error {
 	log.Info().Msg("Spinning up gRPC Agent client...")
 	if grpcConn == nil {
 		grpcConn = &Connection{}
@@ -152,19 +184,10 @@ func Init(grpcContext context.Context,
 	ctx = metadata.AppendToOutgoingContext(ctx, "dyo-node-token", connParams.token)
 
 	if grpcConn.Conn == nil {
-		var creds credentials.TransportCredentials
-
-		httpAddr := fmt.Sprintf("https://%s", connParams.address)
-		certPool, err := fetchCertificatesFromURL(ctx, httpAddr)
+		creds, err := transportCredentials(ctx, connParams.address, appConfig)
 		if err != nil {
-			if appConfig.Debug {
-				log.Warn().Err(err).Msg("Secure mode is disabled in demo/dev environment, falling back to plain-text gRPC")
-				creds = insecure.NewCredentials()
-			} else {
-				log.Panic().Err(err).Msg("Could not fetch valid certificate")
-			}
-		} else {
-			creds = credentials.NewClientTLSFromCert(certPool, "")
+			cancel()
+			return fmt.Errorf("gRPC transport security error: %w", err)
 		}
 
 		// TODO: Missing error or panic when the server don't have a secure connection.
@@ -172,6 +195,10 @@ func Init(grpcContext context.Context,
 		opts := []grpc.DialOption{
 			grpc.WithTransportCredentials(creds),
 			grpc.WithBlock(),
+			grpc.WithConnectParams(grpc.ConnectParams{
+				Backoff:           newReconnectPolicy(appConfig).config,
+				MinConnectTimeout: minConnectTimeout,
+			}),
 			grpc.WithKeepaliveParams(
 				keepalive.ClientParameters{
 					Time:                appConfig.GrpcKeepalive,
@@ -202,7 +229,7 @@ func Init(grpcContext context.Context,
 		grpcConn.Conn = conn
 	}
 
-	grpcLoop(ctx, connParams, workerFuncs, cancel, appConfig)
+	return grpcLoop(ctx, connParams, workerFuncs, cancel, appConfig)
 }
 
 func grpcProcessCommand(
@@ -210,30 +237,202 @@ func grpcProcessCommand(
 	workerFuncs WorkerFunctions,
 	command *agent.AgentCommand,
 	appConfig *config.CommonConfiguration,
+	scheduler *commandScheduler,
 ) {
+	ctx = tracing.Extract(ctx, command.GetTraceContext())
+
+	// deployments can be canceled while they are waiting in the queue, they are dropped without running
+	runCtx, finish := ctx, func() {}
+
+	var scheduled *scheduledCommand
 	switch {
 	case command.GetDeploy() != nil:
-		go executeVersionDeployRequest(ctx, command.GetDeploy(), workerFuncs.Deploy, appConfig)
+		runCtx, finish = registerDeployment(ctx, command.GetDeploy().GetId())
+		cancelCtx := runCtx
+		scheduled = &scheduledCommand{
+			name: "deploy", kind: commandKindMutating, targets: deployTargets(command.GetDeploy()),
+			execute: func() error {
+				return executeVersionDeployRequest(ctx, cancelCtx, command.GetDeploy(), workerFuncs.Deploy, appConfig)
+			},
+		}
 	case command.GetContainerState() != nil:
-		go executeWatchContainerStatus(ctx, command.GetContainerState(), workerFuncs.Watch)
+		kind := commandKindStream
+		if command.GetContainerState().GetOneShot() {
+			kind = commandKindRead
+		}
+		scheduled = &scheduledCommand{
+			name: "containerState", kind: kind,
+			execute: func() error {
+				return executeWatchContainerStatus(ctx, command.GetContainerState(), workerFuncs.Watch, workerFuncs.ContainerEvents, appConfig)
+			},
+		}
 	case command.GetContainerDelete() != nil:
-		go executeDeleteContainer(ctx, command.GetContainerDelete(), workerFuncs.Delete)
+		req := command.GetContainerDelete()
+		scheduled = &scheduledCommand{
+			name: "containerDelete", kind: commandKindMutating, targets: []targetKey{{prefix: req.Prefix, name: req.Name}},
+			execute: func() error { return executeDeleteContainer(ctx, req, workerFuncs.Delete) },
+		}
 	case command.GetDeployLegacy() != nil:
-		go executeVersionDeployLegacyRequest(ctx, command.GetDeployLegacy(), workerFuncs.Deploy, appConfig)
+		runCtx, finish = registerDeployment(ctx, command.GetDeployLegacy().GetRequestId())
+		cancelCtx := runCtx
+		scheduled = &scheduledCommand{
+			name: "deployLegacy", kind: commandKindMutating, targets: deployLegacyTargets(command.GetDeployLegacy()),
+			execute: func() error {
+				return executeVersionDeployLegacyRequest(ctx, cancelCtx, command.GetDeployLegacy(), workerFuncs.Deploy, appConfig)
+			},
+		}
 	case command.GetListSecrets() != nil:
-		go executeSecretList(ctx, command.GetListSecrets(), workerFuncs.SecretList, appConfig)
+		scheduled = &scheduledCommand{
+			name: "listSecrets", kind: commandKindRead,
+			execute: func() error {
+				return executeSecretList(ctx, command.GetListSecrets(), workerFuncs.SecretList, appConfig)
+			},
+		}
 	case command.GetUpdate() != nil:
-		go executeUpdate(ctx, command.GetUpdate(), workerFuncs.SelfUpdate)
+		scheduled = &scheduledCommand{
+			name: "update", kind: commandKindMutating, targets: []targetKey{exclusiveTarget},
+			execute: func() error { return executeUpdate(ctx, command.GetUpdate(), workerFuncs.SelfUpdate) },
+		}
 	case command.GetClose() != nil:
-		go executeClose(ctx, command.GetClose(), workerFuncs.Close)
+		scheduled = &scheduledCommand{
+			name: "close", kind: commandKindControl,
+			execute: func() error { return executeClose(ctx, command.GetClose(), workerFuncs.Close) },
+		}
 	case command.GetContainerCommand() != nil:
-		go executeContainerCommand(ctx, command.GetContainerCommand(), workerFuncs.ContainerCommand)
+		req := command.GetContainerCommand()
+		scheduled = &scheduledCommand{
+			name: "containerCommand", kind: commandKindMutating,
+			targets: []targetKey{{prefix: req.GetContainer().GetPrefix(), name: req.GetContainer().GetName()}},
+			execute: func() error { return executeContainerCommand(ctx, req, workerFuncs.ContainerCommand) },
+		}
 	case command.GetDeleteContainers() != nil:
-		go executeDeleteMultipleContainers(ctx, command.GetDeleteContainers(), workerFuncs.DeleteContainers)
+		req := command.GetDeleteContainers()
+		scheduled = &scheduledCommand{
+			name: "deleteContainers", kind: commandKindMutating, targets: deleteContainersTargets(req),
+			execute: func() error { return executeDeleteMultipleContainers(ctx, req, workerFuncs.DeleteContainers) },
+		}
 	case command.GetContainerLog() != nil:
-		go executeContainerLog(ctx, command.GetContainerLog(), workerFuncs.ContainerLog)
+		kind := commandKindRead
+		if command.GetContainerLog().GetStreaming() {
+			kind = commandKindStream
+		}
+		scheduled = &scheduledCommand{
+			name: "containerLog", kind: kind,
+			execute: func() error { return executeContainerLog(ctx, command.GetContainerLog(), workerFuncs.ContainerLog) },
+		}
+	case command.GetCancelDeployment() != nil:
+		scheduled = &scheduledCommand{
+			name: "cancelDeployment", kind: commandKindControl,
+			execute: func() error { return executeCancelDeployment(command.GetCancelDeployment()) },
+		}
+	case command.GetVolumeBackup() != nil:
+		req := command.GetVolumeBackup()
+		scheduled = &scheduledCommand{
+			name: "volumeBackup", kind: commandKindMutating,
+			targets: []targetKey{{prefix: req.GetContainer().GetPrefix(), name: req.GetContainer().GetName()}},
+			execute: func() error { return executeVolumeBackup(ctx, req, workerFuncs.VolumeBackup) },
+		}
+	case command.GetVolumeRestore() != nil:
+		req := command.GetVolumeRestore()
+		scheduled = &scheduledCommand{
+			name: "volumeRestore", kind: commandKindMutating,
+			targets: []targetKey{{prefix: req.GetContainer().GetPrefix(), name: req.GetContainer().GetName()}},
+			execute: func() error { return executeVolumeRestore(ctx, req, workerFuncs.VolumeRestore) },
+		}
+	case command.GetContainerExec() != nil:
+		scheduled = &scheduledCommand{
+			name: "containerExec", kind: commandKindStream,
+			execute: func() error { return executeContainerExec(ctx, command.GetContainerExec(), workerFuncs.ContainerExec) },
+		}
+	case command.GetContainerStats() != nil:
+		scheduled = &scheduledCommand{
+			name: "containerStats", kind: commandKindStream,
+			execute: func() error {
+				return executeContainerStats(ctx, command.GetContainerStats(), workerFuncs.ContainerStats)
+			},
+		}
+	case command.GetContainerFileList() != nil:
+		scheduled = &scheduledCommand{
+			name: "containerFileList", kind: commandKindRead,
+			execute: func() error {
+				return executeContainerFileList(ctx, command.GetContainerFileList(), workerFuncs.ContainerFileList)
+			},
+		}
+	case command.GetContainerFileDownload() != nil:
+		scheduled = &scheduledCommand{
+			name: "containerFileDownload", kind: commandKindRead,
+			execute: func() error {
+				return executeContainerFileDownload(ctx, command.GetContainerFileDownload(), workerFuncs.ContainerFileDownload)
+			},
+		}
+	case command.GetContainerFileUpload() != nil:
+		req := command.GetContainerFileUpload()
+		scheduled = &scheduledCommand{
+			name: "containerFileUpload", kind: commandKindMutating,
+			targets: []targetKey{{prefix: req.GetContainer().GetPrefix(), name: req.GetContainer().GetName()}},
+			execute: func() error { return executeContainerFileUpload(ctx, req, workerFuncs.ContainerFileUpload) },
+		}
+	case command.GetImagePrune() != nil:
+		scheduled = &scheduledCommand{
+			name: "imagePrune", kind: commandKindMutating, targets: []targetKey{exclusiveTarget},
+			execute: func() error { return executeImagePrune(ctx, command.GetImagePrune(), workerFuncs.ImagePrune) },
+		}
+	case command.GetDiskUsage() != nil:
+		scheduled = &scheduledCommand{
+			name: "diskUsage", kind: commandKindRead,
+			execute: func() error { return executeDiskUsage(ctx, command.GetDiskUsage(), workerFuncs.DiskUsage) },
+		}
 	default:
 		log.Warn().Msg("Unknown agent command")
+		scheduled = &scheduledCommand{
+			name: "unknown", kind: commandKindControl,
+			execute: func() error { return errors.New("unknown agent command") },
+		}
+	}
+
+	go executeCommand(ctx, command.GetCommandId(), func() error {
+		defer finish()
+		return scheduler.run(runCtx, scheduled)
+	})
+}
+
+// registerDeployment makes the deployment cancelable from the moment it is received,
+// the deployment itself validates the id, an empty one is not registered
+func registerDeployment(ctx context.Context, id string) (context.Context, func()) {
+	if id == "" {
+		return ctx, func() {}
+	}
+
+	return runningDeployments.start(ctx, id)
+}
+
+// executeCommand runs a command and reports its outcome to crux, commands without a correlation ID are not reported
+func executeCommand(ctx context.Context, commandID string, execute func() error) {
+	started := time.Now()
+	err := execute()
+	if commandID == "" {
+		return
+	}
+
+	result := &agent.CommandResultRequest{
+		CommandId: commandID,
+		Success:   err == nil,
+		Duration:  durationpb.New(time.Since(started)),
+	}
+	if err != nil {
+		errorMessage := err.Error()
+		result.Error = &errorMessage
+	}
+
+	client := grpcConn.Client
+	if client == nil {
+		log.Warn().Str("commandId", commandID).Msg("Not connected, dropping command result")
+		return
+	}
+
+	_, err = client.CommandResult(ctx, result)
+	if err != nil {
+		log.Error().Stack().Err(err).Str("commandId", commandID).Msg("Command result error")
 	}
 }
 
@@ -243,13 +442,20 @@ func grpcLoop(
 	workerFuncs WorkerFunctions,
 	cancel context.CancelFunc,
 	appConfig *config.CommonConfiguration,
-) {
+) error {
 	var stream agent.Agent_ConnectClient
 	var err error
 	defer cancel()
 	defer grpcConn.Conn.Close()
+
+	reconnect := newReconnectPolicy(appConfig)
+	scheduler := newCommandScheduler(appConfig)
+	scheduler.startPeriodicTasks(ctx, workerFuncs.PeriodicTasks)
+	connectedAt := time.Time{}
 	for {
 		if grpcConn.Client == nil {
+			connectionStates.publish(ConnectionStateEvent{State: ConnectionStateConnecting, Attempt: reconnect.attempt})
+
 			client := agent.NewAgentClient(grpcConn.Conn)
 			grpcConn.SetClient(client)
 
@@ -260,59 +466,90 @@ func grpcLoop(
 			}
 
 			stream, err = grpcConn.Client.Connect(
-				ctx, &agent.AgentInfo{Id: connParams.nodeID, Version: version.BuildVersion(), PublicKey: publicKey},
+				ctx, newAgentInfo(ctx, connParams, &workerFuncs, publicKey),
 				grpc.WaitForReady(true),
 			)
 			if err != nil {
-				log.Error().Stack().Err(err).Send()
-				time.Sleep(time.Second)
+				log.Error().Stack().Err(err).Msg("Failed to connect")
 				grpcConn.Client = nil
+				if err = handleStreamError(ctx, reconnect, err); err != nil {
+					return err
+				}
 				continue
-			} else {
-				log.Info().Msg("Stream connection is up")
 			}
+
+			connectedAt = time.Now()
+			connectionStates.publish(ConnectionStateEvent{State: ConnectionStateConnected})
+			log.Info().Msg("Stream connection is up")
 		}
 
 		command := new(agent.AgentCommand)
 		err = stream.RecvMsg(command)
-		if err == io.EOF {
-			log.Info().Msg("End of stream")
-			grpcConn.Client = nil
-			time.Sleep(appConfig.DefaultTimeout)
-			continue
-		}
 		if err != nil {
-			log.Error().Stack().Err(err).Msg("Cannot receive stream")
+			if err == io.EOF {
+				log.Info().Msg("End of stream")
+			} else {
+				log.Error().Stack().Err(err).Msg("Cannot receive stream")
+			}
+
 			grpcConn.Client = nil
-			time.Sleep(appConfig.DefaultTimeout)
-			// TODO replace the line above with an error status code check and terminate dagent accordingly
+			// rejections also arrive here, so only a long-lived stream counts as a successful attempt
+			if time.Since(connectedAt) > stableConnectionDuration {
+				reconnect.reset()
+			}
+			if err = handleStreamError(ctx, reconnect, err); err != nil {
+				return err
+			}
 			continue
 		}
 
-		grpcProcessCommand(ctx, workerFuncs, command, appConfig)
+		grpcProcessCommand(ctx, workerFuncs, command, appConfig, scheduler)
+	}
+}
+
+// handleStreamError decides whether the agent should reconnect after a stream error,
+// waits for the next attempt if so, otherwise returns the error stopping the loop
+func handleStreamError(ctx context.Context, reconnect *reconnectPolicy, streamErr error) error {
+	if terminalErr := asTerminalError(streamErr); terminalErr != nil {
+		connectionStates.publish(ConnectionStateEvent{State: ConnectionStateTerminated, Error: terminalErr})
+		return terminalErr
+	}
+
+	if err := reconnect.wait(ctx, streamErr); err != nil {
+		connectionStates.publish(ConnectionStateEvent{State: ConnectionStateTerminated, Error: err})
+		return err
 	}
+
+	return nil
 }
 
+// executeVersionDeployRequest deploys the images until the cancel context is done,
+// the status of the deployment is reported with the parent context
 func executeVersionDeployRequest(
-	ctx context.Context, req *agent.VersionDeployRequest,
+	ctx, cancelCtx context.Context, req *agent.VersionDeployRequest,
 	deploy DeployFunc, appConfig *config.CommonConfiguration,
-) {
+) error {
 	if deploy == nil {
 		log.Error().Msg("Deploy function not implemented")
-		return
+		return errors.New("deploy function not implemented")
 	}
 
 	if req.Id == "" {
 		log.Warn().Msg("Empty request id for deployment")
-		return
+		return errors.New("empty request id for deployment")
 	}
+
+	ctx, span := tracing.Start(ctx, "deployment", tracing.DeploymentIDKey.String(req.Id))
+	defer span.End()
+	cancelCtx = trace.ContextWithSpan(cancelCtx, span)
+
 	log.Info().Str("deployment", req.Id).Msg("Opening status channel")
 
-	deployCtx := metadata.AppendToOutgoingContext(ctx, "dyo-deployment-id", req.Id)
+	deployCtx := tracing.AppendToOutgoingContext(metadata.AppendToOutgoingContext(ctx, "dyo-deployment-id", req.Id))
 	statusStream, err := grpcConn.Client.DeploymentStatus(deployCtx, grpc.WaitForReady(true))
 	if err != nil {
 		log.Error().Stack().Err(err).Str("deployment", req.Id).Msg("Status connect error")
-		return
+		return fmt.Errorf("status connect error: %w", err)
 	}
 
 	dog := dogger.NewDeploymentLogger(ctx, &req.Id, statusStream, appConfig)
@@ -321,45 +558,135 @@ func executeVersionDeployRequest(
 
 	if len(req.Requests) < 1 {
 		dog.WriteDeploymentStatus(common.DeploymentStatus_PREPARING, "There were no images to deploy.")
-		return
+		return nil
 	}
 
-	failed := false
-	var deployStatus common.DeploymentStatus
+	// every image is deployed, the worst outcome of them is the status of the deployment
+	status := common.DeploymentStatus_SUCCESSFUL
+	deployErrs := []error{}
 	for i := range req.Requests {
 		imageReq := mapper.MapDeployImage(req.Requests[i], appConfig)
 		dog.SetRequestID(imageReq.RequestID)
 
+		if cancelErr := cancelCtx.Err(); cancelErr != nil {
+			deployErrs = append(deployErrs, fmt.Errorf("deploying %s interrupted: %w", imageReq.ContainerConfig.Container, cancelErr))
+			status = worseDeploymentStatus(status, common.DeploymentStatus_OBSOLETE)
+			break
+		}
+
 		var versionData *v1.VersionData
 		if len(req.VersionName) > 0 {
 			versionData = &v1.VersionData{Version: req.VersionName, ReleaseNotes: req.ReleaseNotes}
 		}
 
-		if err = deploy(ctx, dog, imageReq, versionData); err != nil {
-			failed = true
+		imageCtx, imageSpan := tracing.Start(cancelCtx, "deploy image",
+			tracing.ContainerKey.String(imageReq.ContainerConfig.Container), tracing.ImageKey.String(imageReq.ImageName))
+		err = deploy(imageCtx, dog, imageReq, versionData)
+		tracing.End(imageSpan, err)
+		if err != nil {
 			dog.Write(err.Error())
+			deployErrs = append(deployErrs, err)
+			status = worseDeploymentStatus(status, deploymentStatusOf(cancelCtx, dog, err))
 		}
 	}
+	deployErr := joinDeployErrors(deployErrs)
+	tracing.Fail(span, deployErr)
 
-	if failed {
-		deployStatus = common.DeploymentStatus_FAILED
-	} else {
-		deployStatus = common.DeploymentStatus_SUCCESSFUL
-	}
-
-	dog.WriteDeploymentStatus(deployStatus)
+	dog.WriteDeploymentStatus(status)
 
 	err = statusStream.CloseSend()
 	if err != nil {
 		log.Error().Stack().Err(err).Str("deployment", req.Id).Msg("Status close error")
-		return
 	}
+
+	if deployErr != nil {
+		return fmt.Errorf("deployment failed: %w", deployErr)
+	}
+	return nil
+}
+
+// DowngradedError is returned by the deploy functions if the deployment failed, but the previous version
+// of the container was restored
+type DowngradedError struct {
+	Err error
+}
+
+func (err *DowngradedError) Error() string {
+	return fmt.Sprintf("rolled back to the previous version: %s", err.Err.Error())
+}
+
+func (err *DowngradedError) Unwrap() error {
+	return err.Err
+}
+
+// deploymentStatusOf returns the status of a failed image, a canceled one is obsolete instead of failed,
+// a rolled back one is downgraded
+func deploymentStatusOf(deployCtx context.Context, dog *dogger.DeploymentLogger, deployErr error) common.DeploymentStatus {
+	if downgraded := new(DowngradedError); errors.As(deployErr, &downgraded) {
+		dog.Write("Deployment downgraded: " + downgraded.Error())
+		return common.DeploymentStatus_DOWNGRADED
+	}
+
+	if deployCtx.Err() != nil {
+		dog.Write("Deployment canceled: " + deployErr.Error())
+		return common.DeploymentStatus_OBSOLETE
+	}
+
+	return common.DeploymentStatus_FAILED
+}
+
+// worseDeploymentStatus keeps the status hiding the least, a failed container is down, a downgraded one
+// runs the previous version, an obsolete one was left as it was
+func worseDeploymentStatus(status, other common.DeploymentStatus) common.DeploymentStatus {
+	severity := map[common.DeploymentStatus]int{
+		common.DeploymentStatus_OBSOLETE:   1,
+		common.DeploymentStatus_DOWNGRADED: 2,
+		common.DeploymentStatus_FAILED:     3,
+	}
+
+	if severity[other] > severity[status] {
+		return other
+	}
+	return status
 }
 
-func executeWatchContainerStatus(ctx context.Context, req *agent.ContainerStateRequest, listFn WatchFunc) {
+// joinDeployErrors combines the errors of the images, a single error is kept as it is
+func joinDeployErrors(errs []error) error {
+	switch len(errs) {
+	case 0:
+		return nil
+	case 1:
+		return errs[0]
+	default:
+		messages := make([]string, 0, len(errs))
+		for _, err := range errs {
+			messages = append(messages, err.Error())
+		}
+		return errors.New(strings.Join(messages, "; "))
+	}
+}
+
+func executeCancelDeployment(req *agent.CancelDeploymentRequest) error {
+	log.Info().Str("deployment", req.Id).Msg("Canceling deployment")
+
+	if !runningDeployments.cancel(req.Id) {
+		log.Warn().Str("deployment", req.Id).Msg("Deployment to cancel is not running")
+		return fmt.Errorf("deployment is not running: %s", req.Id)
+	}
+
+	return nil
+}
+
+func executeWatchContainerStatus(
+	ctx context.Context,
+	req *agent.ContainerStateRequest,
+	listFn WatchFunc,
+	eventsFn ContainerEventsFunc,
+	appConfig *config.CommonConfiguration,
+) error {
 	if listFn == nil {
 		log.Error().Msg("List function not implemented")
-		return
+		return errors.New("list function not implemented")
 	}
 
 	filterPrefix := ""
@@ -373,40 +700,151 @@ func executeWatchContainerStatus(ctx context.Context, req *agent.ContainerStateR
 	stream, err := grpcConn.Client.ContainerState(streamCtx, grpc.WaitForReady(true))
 	if err != nil {
 		log.Error().Err(err).Msg("Failed to open container status channel")
-		return
+		return fmt.Errorf("failed to open container status channel: %w", err)
 	}
 
-	for {
-		containers := listFn(ctx, filterPrefix)
-
+	if req.OneShot != nil && *req.OneShot {
 		err = stream.Send(&common.ContainerStateListMessage{
 			Prefix: req.Prefix,
-			Data:   containers,
+			Data:   listFn(ctx, filterPrefix),
 		})
-
 		if err != nil {
 			log.Error().Err(err).Msg("Container status channel error")
-			break
+			return fmt.Errorf("container status channel error: %w", err)
 		}
 
-		if req.OneShot != nil && *req.OneShot {
-			err := stream.CloseSend()
-			if err == nil {
-				log.Info().Str("prefix", filterPrefix).Msg("Closed container status channel")
-			} else {
-				log.Error().Err(err).Str("prefix", filterPrefix).Msg("Failed to close container status channel")
+		err = stream.CloseSend()
+		if err == nil {
+			log.Info().Str("prefix", filterPrefix).Msg("Closed container status channel")
+		} else {
+			log.Error().Err(err).Str("prefix", filterPrefix).Msg("Failed to close container status channel")
+		}
+		return nil
+	}
+
+	watchCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	// crux ends the watch by closing the stream
+	go func() {
+		for {
+			var msg interface{}
+			if err := stream.RecvMsg(&msg); err != nil {
+				cancel()
+				return
+			}
+		}
+	}()
+
+	return watchContainerStatus(watchCtx, stream, req.Prefix, req.GetPartial(), listFn, eventsFn, appConfig.ContainerStateSnapshotInterval)
+}
+
+const defaultSnapshotInterval = 30 * time.Second
+
+// watchContainerStatus sends the full container list periodically and whenever the container events report
+// a change, only the changes are sent in between the snapshots if crux asked for partial messages
+func watchContainerStatus(
+	ctx context.Context,
+	stream agent.Agent_ContainerStateClient,
+	prefix *string,
+	partial bool,
+	listFn WatchFunc,
+	eventsFn ContainerEventsFunc,
+	snapshotInterval time.Duration,
+) error {
+	filterPrefix := ""
+	if prefix != nil {
+		filterPrefix = *prefix
+	}
+
+	subscribe := func() <-chan struct{} {
+		if eventsFn == nil {
+			return nil
+		}
+
+		events, err := eventsFn(ctx, filterPrefix)
+		if err != nil {
+			log.Warn().Err(err).Str("prefix", filterPrefix).Msg("Failed to subscribe to container events, sending snapshots only")
+			return nil
+		}
+		return events
+	}
+
+	cache := containerStateCache{}
+	sendSnapshot := func() error {
+		containers := listFn(ctx, filterPrefix)
+		cache.update(containers)
+
+		return stream.Send(&common.ContainerStateListMessage{
+			Prefix: prefix,
+			Data:   containers,
+		})
+	}
+
+	events := subscribe()
+	if err := sendSnapshot(); err != nil {
+		log.Error().Err(err).Msg("Container status channel error")
+		return fmt.Errorf("container status channel error: %w", err)
+	}
+
+	if snapshotInterval <= 0 {
+		snapshotInterval = defaultSnapshotInterval
+	}
+	ticker := time.NewTicker(snapshotInterval)
+	defer ticker.Stop()
+
+	for {
+		var err error
+		select {
+		case <-ctx.Done():
+			log.Info().Str("prefix", filterPrefix).Msg("Closed container status channel")
+			return nil
+		case _, ok := <-events:
+			if !ok {
+				log.Warn().Str("prefix", filterPrefix).Msg("Container events closed, sending snapshots only")
+				events = nil
+				continue
+			}
+
+			containers := listFn(ctx, filterPrefix)
+			changed, removed := cache.update(containers)
+			if len(changed) == 0 && len(removed) == 0 {
+				continue
+			}
+
+			if !partial {
+				err = stream.Send(&common.ContainerStateListMessage{
+					Prefix: prefix,
+					Data:   containers,
+				})
+				break
+			}
+
+			err = stream.Send(&common.ContainerStateListMessage{
+				Prefix:  prefix,
+				Partial: true,
+				Data:    changed,
+				Removed: removed,
+			})
+		case <-ticker.C:
+			if events == nil {
+				events = subscribe()
 			}
-			return
+
+			err = sendSnapshot()
 		}
 
-		time.Sleep(time.Second)
+		if err != nil {
+			log.Error().Err(err).Msg("Container status channel error")
+			return fmt.Errorf("container status channel error: %w", err)
+		}
 	}
 }
 
-func executeDeleteContainer(ctx context.Context, req *agent.ContainerDeleteRequest, deleteFn DeleteFunc) {
+func executeDeleteContainer(ctx context.Context, req *agent.ContainerDeleteRequest, deleteFn DeleteFunc) error {
 	if deleteFn == nil {
 		log.Error().Msg("Delete function not implemented")
-		return
+		return errors.New("delete function not implemented")
 	}
 
 	log.Info().Str("prefix", req.Prefix).Str("name", req.Name).Msg("Deleting container")
@@ -414,13 +852,15 @@ func executeDeleteContainer(ctx context.Context, req *agent.ContainerDeleteReque
 	err := deleteFn(ctx, req.Prefix, req.Name)
 	if err != nil {
 		log.Error().Err(err).Msg("Failed to delete container")
+		return fmt.Errorf("delete failed: %w", err)
 	}
+	return nil
 }
 
-func executeDeleteMultipleContainers(ctx context.Context, req *common.DeleteContainersRequest, deleteFn DeleteContainersFunc) {
+func executeDeleteMultipleContainers(ctx context.Context, req *common.DeleteContainersRequest, deleteFn DeleteContainersFunc) error {
 	if deleteFn == nil {
 		log.Error().Msg("Delete function not implemented")
-		return
+		return errors.New("delete function not implemented")
 	}
 
 	log.Info().Msg("Deleting multiple containers")
@@ -428,29 +868,36 @@ func executeDeleteMultipleContainers(ctx context.Context, req *common.DeleteCont
 	err := deleteFn(ctx, req)
 	if err != nil {
 		log.Error().Stack().Err(err).Msg("Failed to delete multiple containers")
+		return fmt.Errorf("delete failed: %w", err)
 	}
+	return nil
 }
 
 func executeVersionDeployLegacyRequest(
-	ctx context.Context, req *agent.DeployRequestLegacy,
+	ctx, cancelCtx context.Context, req *agent.DeployRequestLegacy,
 	deploy DeployFunc, appConfig *config.CommonConfiguration,
-) {
+) error {
 	if deploy == nil {
 		log.Error().Msg("Deploy function not implemented")
-		return
+		return errors.New("deploy function not implemented")
 	}
 
 	if req.RequestId == "" {
 		log.Warn().Msg("Empty request id for legacy deployment")
-		return
+		return errors.New("empty request id for legacy deployment")
 	}
+
+	ctx, span := tracing.Start(ctx, "deployment", tracing.DeploymentIDKey.String(req.RequestId))
+	defer span.End()
+	cancelCtx = trace.ContextWithSpan(cancelCtx, span)
+
 	log.Info().Str("deployment", req.RequestId).Msg("Opening status channel.")
 
-	deployCtx := metadata.AppendToOutgoingContext(ctx, "dyo-deployment-id", req.RequestId)
+	deployCtx := tracing.AppendToOutgoingContext(metadata.AppendToOutgoingContext(ctx, "dyo-deployment-id", req.RequestId))
 	statusStream, err := grpcConn.Client.DeploymentStatus(deployCtx, grpc.WaitForReady(true))
 	if err != nil {
 		log.Error().Stack().Err(err).Str("deployment", req.RequestId).Msg("Status connect error")
-		return
+		return fmt.Errorf("status connect error: %w", err)
 	}
 
 	dog := dogger.NewDeploymentLogger(ctx, &req.RequestId, statusStream, appConfig)
@@ -462,23 +909,25 @@ func executeVersionDeployLegacyRequest(
 
 		errorText := fmt.Sprintf("JSON parse error: %v", err)
 		dog.WriteDeploymentStatus(common.DeploymentStatus_FAILED, errorText)
-		return
+		return errors.New(errorText)
 	}
 
 	dog.WriteDeploymentStatus(common.DeploymentStatus_IN_PROGRESS, "Started.")
 
 	t1 := time.Now()
 
-	deployStatus := common.DeploymentStatus_SUCCESSFUL
-	if err = deploy(ctx, dog, &deployImageRequest, nil); err == nil {
+	deployErr := deploy(cancelCtx, dog, &deployImageRequest, nil)
+	tracing.Fail(span, deployErr)
+	status := common.DeploymentStatus_SUCCESSFUL
+	if deployErr == nil {
 		dog.Write(fmt.Sprintf("Deployment took: %.2f seconds", time.Since(t1).Seconds()))
 		dog.Write("Deployment succeeded.")
 	} else {
-		deployStatus = common.DeploymentStatus_FAILED
-		dog.Write("Deployment failed " + err.Error())
+		dog.Write("Deployment failed " + deployErr.Error())
+		status = deploymentStatusOf(cancelCtx, dog, deployErr)
 	}
 
-	dog.WriteDeploymentStatus(deployStatus)
+	dog.WriteDeploymentStatus(status)
 
 	err = statusStream.CloseSend()
 	if err != nil {
@@ -486,8 +935,12 @@ func executeVersionDeployLegacyRequest(
 			Str("deployment", req.RequestId).
 			Str("deployImageRequestId", deployImageRequest.RequestID).
 			Msg("Status close err")
-		return
 	}
+
+	if deployErr != nil {
+		return fmt.Errorf("deployment failed: %w", deployErr)
+	}
+	return nil
 }
 
 func executeSecretList(
@@ -495,10 +948,10 @@ func executeSecretList(
 	command *agent.ListSecretsRequest,
 	listFunc SecretListFunc,
 	appConfig *config.CommonConfiguration,
-) {
+) error {
 	if listFunc == nil {
 		log.Error().Msg("Secret list function not implemented")
-		return
+		return errors.New("secret list function not implemented")
 	}
 
 	prefix := command.Prefix
@@ -509,13 +962,13 @@ func executeSecretList(
 	keys, err := listFunc(ctx, prefix, name)
 	if err != nil {
 		log.Error().Stack().Err(err).Msg("Secret list error")
-		return
+		return fmt.Errorf("secret list failed: %w", err)
 	}
 
 	publicKey, err := config.GetPublicKey(appConfig.SecretPrivateKey)
 	if err != nil {
 		log.Error().Stack().Err(err).Msg("Failed to get public key")
-		return
+		return fmt.Errorf("failed to get public key: %w", err)
 	}
 
 	resp := &common.ListSecretsResponse{
@@ -529,14 +982,15 @@ func executeSecretList(
 	_, err = grpcConn.Client.SecretList(ctx, resp)
 	if err != nil {
 		log.Error().Stack().Err(err).Msg("Secret list response error")
-		return
+		return fmt.Errorf("secret list response error: %w", err)
 	}
+	return nil
 }
 
-func executeUpdate(ctx context.Context, command *agent.AgentUpdateRequest, updateFunc SelfUpdateFunc) {
+func executeUpdate(ctx context.Context, command *agent.AgentUpdateRequest, updateFunc SelfUpdateFunc) error {
 	if updateFunc == nil {
 		log.Error().Msg("Self update function not implemented")
-		return
+		return errors.New("self update function not implemented")
 	}
 
 	err := updateFunc(ctx, command.Tag, command.TimeoutSeconds)
@@ -548,35 +1002,40 @@ func executeUpdate(ctx context.Context, command *agent.AgentUpdateRequest, updat
 			Error: strings.ToUpper(errorString[0:1]) + errorString[1:],
 		}
 
-		_, err := grpcConn.Client.AbortUpdate(ctx, resp)
-		if err != nil {
-			log.Error().Stack().Err(err).Msg("Update abort request error")
+		_, abortErr := grpcConn.Client.AbortUpdate(ctx, resp)
+		if abortErr != nil {
+			log.Error().Stack().Err(abortErr).Msg("Update abort request error")
 		}
+
+		return fmt.Errorf("update failed: %w", err)
 	}
+	return nil
 }
 
-func executeClose(ctx context.Context, command *agent.CloseConnectionRequest, closeFunc CloseFunc) {
+func executeClose(ctx context.Context, command *agent.CloseConnectionRequest, closeFunc CloseFunc) error {
 	if closeFunc == nil {
 		log.Error().Msg("Close function not implemented")
-		return
+		return errors.New("close function not implemented")
 	}
 
 	log.Debug().Str("reason", agent.CloseReason_name[int32(command.GetReason())]).Msg("gRPC connection remotely closed")
 
-	if closeFunc == nil {
-		return
-	}
-
 	err := closeFunc(ctx, command.Reason)
 	if err != nil {
 		log.Error().Stack().Err(err).Msg("Close handler error")
+		return fmt.Errorf("close failed: %w", err)
 	}
+	return nil
 }
 
-func executeContainerCommand(ctx context.Context, command *common.ContainerCommandRequest, containerCommandFunc ContainerCommandFunc) {
+func executeContainerCommand(
+	ctx context.Context,
+	command *common.ContainerCommandRequest,
+	containerCommandFunc ContainerCommandFunc,
+) error {
 	if containerCommandFunc == nil {
 		log.Error().Msg("Container command function not implemented")
-		return
+		return errors.New("container command function not implemented")
 	}
 
 	log.Info().
@@ -588,7 +1047,10 @@ func executeContainerCommand(ctx context.Context, command *common.ContainerComma
 	err := containerCommandFunc(ctx, command)
 	if err != nil {
 		log.Error().Stack().Err(err).Msg("Container Command error")
+		operation := strings.TrimSuffix(command.Operation.String(), "_CONTAINER")
+		return fmt.Errorf("%s failed: %w", strings.ToLower(operation), err)
 	}
+	return nil
 }
 
 func streamContainerLog(reader ContainerLogReader,
@@ -636,10 +1098,10 @@ func streamContainerLog(reader ContainerLogReader,
 	}
 }
 
-func executeContainerLog(ctx context.Context, command *agent.ContainerLogRequest, logFunc ContainerLogFunc) {
+func executeContainerLog(ctx context.Context, command *agent.ContainerLogRequest, logFunc ContainerLogFunc) error {
 	if logFunc == nil {
 		log.Error().Msg("Container log function not implemented")
-		return
+		return errors.New("container log function not implemented")
 	}
 
 	prefix := command.Container.Prefix
@@ -654,9 +1116,12 @@ func executeContainerLog(ctx context.Context, command *agent.ContainerLogRequest
 	stream, err := grpcConn.Client.ContainerLog(streamCtx, grpc.WaitForReady(true))
 	if err != nil {
 		log.Error().Err(err).Str("prefix", prefix).Str("name", name).Msg("Failed to open container log channel")
-		return
+		return fmt.Errorf("failed to open container log channel: %w", err)
 	}
 
+	metrics.ActiveLogStreams.Inc()
+	defer metrics.ActiveLogStreams.Dec()
+
 	defer func() {
 		err = stream.CloseSend()
 		if err != nil {
@@ -669,7 +1134,7 @@ func executeContainerLog(ctx context.Context, command *agent.ContainerLogRequest
 	logContext, err := logFunc(streamCtx, command)
 	if err != nil {
 		log.Error().Err(err).Str("prefix", prefix).Str("name", name).Msg("Failed to open container log reader")
-		return
+		return fmt.Errorf("failed to open container log reader: %w", err)
 	}
 
 	reader := logContext.Reader
@@ -694,6 +1159,7 @@ func executeContainerLog(ctx context.Context, command *agent.ContainerLogRequest
 	<-streamCtx.Done()
 
 	log.Trace().Str("prefix", prefix).Str("name", name).Msg("Container log exited")
+	return nil
 }
 
 func WithGRPCConfig(parentContext context.Context, cfg any) context.Context {
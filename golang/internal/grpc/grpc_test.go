@@ -0,0 +1,75 @@
+//go:build unit
+// +build unit
+
+package grpc_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dyrector-io/dyrectorio/golang/internal/grpc"
+	"github.com/dyrector-io/dyrectorio/protobuf/go/common"
+)
+
+func testContainerCommand() *common.ContainerCommandRequest {
+	return &common.ContainerCommandRequest{
+		Container: &common.ContainerIdentifier{Prefix: "prefix", Name: "name"},
+		Operation: common.ContainerOperation_STOP_CONTAINER,
+	}
+}
+
+func TestContainerCommandErrorNamesTheOperation(t *testing.T) {
+	err := grpc.ExecuteContainerCommandForTest(context.Background(), testContainerCommand(),
+		func(context.Context, *common.ContainerCommandRequest) error {
+			return errors.New("container not found")
+		})
+
+	assert.EqualError(t, err, "stop failed: container not found")
+}
+
+func TestContainerCommandSuccess(t *testing.T) {
+	err := grpc.ExecuteContainerCommandForTest(context.Background(), testContainerCommand(),
+		func(context.Context, *common.ContainerCommandRequest) error {
+			return nil
+		})
+
+	assert.NoError(t, err)
+}
+
+func TestContainerCommandNotImplemented(t *testing.T) {
+	err := grpc.ExecuteContainerCommandForTest(context.Background(), testContainerCommand(), nil)
+
+	assert.Error(t, err)
+}
+
+func TestDowngradedErrorUnwrapsTheCause(t *testing.T) {
+	cause := errors.New("container exited")
+	var err error = &grpc.DowngradedError{Err: cause}
+
+	assert.ErrorIs(t, err, cause)
+	assert.EqualError(t, err, "rolled back to the previous version: container exited")
+}
+
+func TestWorseDeploymentStatusDoesNotDependOnTheImageOrder(t *testing.T) {
+	images := [][]common.DeploymentStatus{
+		{common.DeploymentStatus_FAILED, common.DeploymentStatus_DOWNGRADED},
+		{common.DeploymentStatus_DOWNGRADED, common.DeploymentStatus_FAILED},
+		{common.DeploymentStatus_OBSOLETE, common.DeploymentStatus_FAILED},
+	}
+
+	for _, statuses := range images {
+		status := common.DeploymentStatus_SUCCESSFUL
+		for _, imageStatus := range statuses {
+			status = grpc.WorseDeploymentStatusForTest(status, imageStatus)
+		}
+		assert.Equal(t, common.DeploymentStatus_FAILED, status)
+	}
+
+	status := grpc.WorseDeploymentStatusForTest(common.DeploymentStatus_OBSOLETE, common.DeploymentStatus_DOWNGRADED)
+	assert.Equal(t, common.DeploymentStatus_DOWNGRADED, status)
+	status = grpc.WorseDeploymentStatusForTest(common.DeploymentStatus_SUCCESSFUL, common.DeploymentStatus_OBSOLETE)
+	assert.Equal(t, common.DeploymentStatus_OBSOLETE, status)
+}
@@ -0,0 +1,233 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"google.golang.org/grpc/backoff"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/dyrector-io/dyrectorio/golang/internal/config"
+	"github.com/dyrector-io/dyrectorio/golang/internal/metrics"
+)
+
+// TerminalExitCode is the process exit code used when crux refuses the agent for good,
+// it is the same as EX_NOPERM from sysexits.h
+const TerminalExitCode = 77
+
+const (
+	// a stream has to stay up this long before the reconnect backoff starts over
+	stableConnectionDuration = 30 * time.Second
+	// same as the gRPC default, dialing is given at least this much time regardless of the backoff
+	minConnectTimeout = 20 * time.Second
+)
+
+// ConnectionState is the state of the agent's command stream towards crux
+type ConnectionState int32
+
+const (
+	ConnectionStateConnecting ConnectionState = iota
+	ConnectionStateConnected
+	ConnectionStateDisconnected
+	ConnectionStateTerminated
+)
+
+var connectionStateNames = map[ConnectionState]string{
+	ConnectionStateConnecting:   "connecting",
+	ConnectionStateConnected:    "connected",
+	ConnectionStateDisconnected: "disconnected",
+	ConnectionStateTerminated:   "terminated",
+}
+
+func (state ConnectionState) String() string {
+	if name, ok := connectionStateNames[state]; ok {
+		return name
+	}
+	return "unknown"
+}
+
+func connectionStateLabels() []string {
+	labels := []string{}
+	for _, name := range connectionStateNames {
+		labels = append(labels, name)
+	}
+	return labels
+}
+
+// ConnectionStateEvent is emitted every time the state of the command stream changes.
+// Attempt is the number of consecutive failed attempts, Delay is the time until the next one.
+type ConnectionStateEvent struct {
+	State   ConnectionState
+	Error   error
+	Attempt int
+	Delay   time.Duration
+}
+
+// TerminalError means crux rejected the agent in a way retrying can not fix, eg. a revoked token
+type TerminalError struct {
+	Code codes.Code
+	Err  error
+}
+
+func (err *TerminalError) Error() string {
+	return fmt.Sprintf("connection terminated by the server (%s): %s", err.Code.String(), status.Convert(err.Err).Message())
+}
+
+func (err *TerminalError) Unwrap() error {
+	return err.Err
+}
+
+// asTerminalError returns a TerminalError if the status code of err means that reconnecting is pointless
+func asTerminalError(err error) *TerminalError {
+	code := status.Code(err)
+	switch code {
+	case codes.Unauthenticated, codes.PermissionDenied:
+		return &TerminalError{Code: code, Err: err}
+	default:
+		return nil
+	}
+}
+
+type connectionStateBroker struct {
+	mutex       sync.RWMutex
+	last        ConnectionStateEvent
+	nextID      int
+	subscribers map[int]chan ConnectionStateEvent
+}
+
+var connectionStates = &connectionStateBroker{
+	last:        ConnectionStateEvent{State: ConnectionStateDisconnected},
+	subscribers: map[int]chan ConnectionStateEvent{},
+}
+
+// SubscribeConnectionState returns a channel receiving the connection state changes of the command stream,
+// starting with the current one, and a function to unsubscribe.
+// Slow subscribers miss events instead of blocking the connection loop.
+func SubscribeConnectionState() (events <-chan ConnectionStateEvent, unsubscribe func()) {
+	const eventBufferSize = 16
+
+	connectionStates.mutex.Lock()
+	defer connectionStates.mutex.Unlock()
+
+	id := connectionStates.nextID
+	connectionStates.nextID++
+
+	channel := make(chan ConnectionStateEvent, eventBufferSize)
+	channel <- connectionStates.last
+	connectionStates.subscribers[id] = channel
+
+	return channel, func() {
+		connectionStates.mutex.Lock()
+		defer connectionStates.mutex.Unlock()
+
+		if _, ok := connectionStates.subscribers[id]; ok {
+			delete(connectionStates.subscribers, id)
+			close(channel)
+		}
+	}
+}
+
+// GetConnectionState returns the last connection state event of the command stream
+func GetConnectionState() ConnectionStateEvent {
+	connectionStates.mutex.RLock()
+	defer connectionStates.mutex.RUnlock()
+
+	return connectionStates.last
+}
+
+func (broker *connectionStateBroker) publish(event ConnectionStateEvent) {
+	broker.mutex.Lock()
+	defer broker.mutex.Unlock()
+
+	broker.last = event
+	metrics.SetConnectionState(event.State.String(), connectionStateLabels())
+	for _, subscriber := range broker.subscribers {
+		select {
+		case subscriber <- event:
+		default:
+			log.Warn().Str("state", event.State.String()).Msg("Connection state subscriber is full, dropping event")
+		}
+	}
+}
+
+// reconnectPolicy implements capped exponential backoff with jitter,
+// the same algorithm gRPC uses for reconnecting a channel.
+type reconnectPolicy struct {
+	config  backoff.Config
+	attempt int
+	random  *rand.Rand
+}
+
+func newReconnectPolicy(appConfig *config.CommonConfiguration) *reconnectPolicy {
+	return &reconnectPolicy{
+		config: backoff.Config{
+			BaseDelay:  appConfig.GrpcReconnectBaseDelay,
+			Multiplier: backoff.DefaultConfig.Multiplier,
+			Jitter:     appConfig.GrpcReconnectJitter,
+			MaxDelay:   appConfig.GrpcReconnectMaxDelay,
+		},
+		// the global source is not seeded on go1.19, every agent would jitter the same way
+		random: rand.New(rand.NewSource(time.Now().UnixNano())), //nolint:gosec
+	}
+}
+
+// delay returns the time to wait before the given retry, unlike gRPC the first retry is randomized too,
+// agents disconnected by a server restart should not come back all at once
+func (policy *reconnectPolicy) delay(retries int) time.Duration {
+	delay, maxDelay := float64(policy.config.BaseDelay), float64(policy.config.MaxDelay)
+	for delay < maxDelay && retries > 0 {
+		delay *= policy.config.Multiplier
+		retries--
+	}
+	if delay > maxDelay {
+		delay = maxDelay
+	}
+
+	delay *= 1 + policy.config.Jitter*(policy.random.Float64()*2-1)
+
+	if delay < 0 {
+		return 0
+	}
+	return time.Duration(delay)
+}
+
+func (policy *reconnectPolicy) reset() {
+	policy.attempt = 0
+}
+
+// wait blocks until the next attempt is due, returns the context error if it is canceled in the meantime
+func (policy *reconnectPolicy) wait(ctx context.Context, cause error) error {
+	delay := policy.delay(policy.attempt)
+	policy.attempt++
+
+	connectionStates.publish(ConnectionStateEvent{
+		State:   ConnectionStateDisconnected,
+		Error:   cause,
+		Attempt: policy.attempt,
+		Delay:   delay,
+	})
+	log.Warn().Int("attempt", policy.attempt).Dur("delay", delay).Msg("Reconnecting")
+	metrics.Reconnects.Inc()
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// IsTerminalError checks whether err was caused by the server refusing the agent for good
+func IsTerminalError(err error) bool {
+	var terminalErr *TerminalError
+	return errors.As(err, &terminalErr)
+}
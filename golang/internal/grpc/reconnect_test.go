@@ -0,0 +1,76 @@
+//go:build unit
+// +build unit
+
+package grpc_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/dyrector-io/dyrectorio/golang/internal/config"
+	"github.com/dyrector-io/dyrectorio/golang/internal/grpc"
+)
+
+func testReconnectConfig() *config.CommonConfiguration {
+	return &config.CommonConfiguration{
+		GrpcReconnectBaseDelay: time.Second,
+		GrpcReconnectMaxDelay:  time.Minute,
+		GrpcReconnectJitter:    0.5,
+	}
+}
+
+func TestReconnectDelayIsJitteredAroundBase(t *testing.T) {
+	cfg := testReconnectConfig()
+
+	for i := 0; i < 100; i++ {
+		delay := grpc.ReconnectDelayForTest(cfg, 0)
+		assert.GreaterOrEqual(t, delay, 500*time.Millisecond)
+		assert.LessOrEqual(t, delay, 1500*time.Millisecond)
+	}
+}
+
+func TestReconnectDelayIsCapped(t *testing.T) {
+	cfg := testReconnectConfig()
+
+	for i := 0; i < 100; i++ {
+		delay := grpc.ReconnectDelayForTest(cfg, 100)
+		assert.GreaterOrEqual(t, delay, 30*time.Second)
+		assert.LessOrEqual(t, delay, 90*time.Second)
+	}
+}
+
+func TestReconnectDelayWithoutJitter(t *testing.T) {
+	cfg := testReconnectConfig()
+	cfg.GrpcReconnectJitter = 0
+
+	assert.Equal(t, time.Second, grpc.ReconnectDelayForTest(cfg, 0))
+	assert.Equal(t, 1600*time.Millisecond, grpc.ReconnectDelayForTest(cfg, 1))
+	assert.Equal(t, time.Minute, grpc.ReconnectDelayForTest(cfg, 20))
+}
+
+func TestTerminalErrors(t *testing.T) {
+	for _, code := range []codes.Code{codes.Unauthenticated, codes.PermissionDenied} {
+		err := grpc.AsTerminalErrorForTest(status.Error(code, "token revoked"))
+
+		assert.Error(t, err)
+		assert.True(t, grpc.IsTerminalError(err))
+		assert.True(t, grpc.IsTerminalError(fmt.Errorf("wrapped: %w", err)))
+	}
+}
+
+func TestRetryableErrors(t *testing.T) {
+	for _, err := range []error{
+		status.Error(codes.Unavailable, "server restarting"),
+		status.Error(codes.Internal, "stream reset"),
+		errors.New("EOF"),
+	} {
+		assert.Nil(t, grpc.AsTerminalErrorForTest(err))
+		assert.False(t, grpc.IsTerminalError(err))
+	}
+}
@@ -0,0 +1,296 @@
+package grpc
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/rs/zerolog/log"
+
+	v1 "github.com/dyrector-io/dyrectorio/golang/api/v1"
+	"github.com/dyrector-io/dyrectorio/golang/internal/config"
+	"github.com/dyrector-io/dyrectorio/golang/internal/metrics"
+	"github.com/dyrector-io/dyrectorio/protobuf/go/agent"
+	"github.com/dyrector-io/dyrectorio/protobuf/go/common"
+)
+
+type commandKind int
+
+const (
+	// one-shot reads, they run in parallel up to the read concurrency limit
+	commandKindRead commandKind = iota
+	// commands changing containers, limited by the command concurrency and serialized by their targets
+	commandKindMutating
+	// commands which must never wait behind others, eg. canceling a deployment
+	commandKindControl
+	// streams open until crux closes them, eg. a followed log, they have their own limit
+	// as they would block the one-shot reads for their whole lifetime
+	commandKindStream
+)
+
+// targetKey identifies the container a command operates on, an empty name stands for the whole prefix
+type targetKey struct {
+	prefix    string
+	name      string
+	exclusive bool
+}
+
+// exclusiveTarget conflicts with every target, eg. the agent update must not restart the agent during a deployment
+var exclusiveTarget = targetKey{exclusive: true}
+
+func (key targetKey) conflicts(other targetKey) bool {
+	if key.exclusive || other.exclusive {
+		return true
+	}
+	if key.prefix != other.prefix {
+		return false
+	}
+	return key.name == "" || other.name == "" || key.name == other.name
+}
+
+type scheduledCommand struct {
+	name    string
+	kind    commandKind
+	targets []targetKey
+	execute func() error
+}
+
+type targetLock struct {
+	targets  []targetKey
+	released chan struct{}
+}
+
+func (lock *targetLock) conflicts(other *targetLock) bool {
+	for _, target := range lock.targets {
+		for _, otherTarget := range other.targets {
+			if target.conflicts(otherTarget) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// targetLocks serializes commands with conflicting targets in the order they arrived
+type targetLocks struct {
+	mutex sync.Mutex
+	queue []*targetLock
+}
+
+func (locks *targetLocks) lock(ctx context.Context, targets []targetKey) (func(), error) {
+	lock := &targetLock{targets: targets, released: make(chan struct{})}
+
+	locks.mutex.Lock()
+	locks.queue = append(locks.queue, lock)
+	locks.mutex.Unlock()
+
+	release := func() {
+		locks.mutex.Lock()
+		for i := range locks.queue {
+			if locks.queue[i] == lock {
+				locks.queue = append(locks.queue[:i], locks.queue[i+1:]...)
+				break
+			}
+		}
+		locks.mutex.Unlock()
+
+		close(lock.released)
+	}
+
+	for {
+		blocker := locks.blocker(lock)
+		if blocker == nil {
+			return release, nil
+		}
+
+		select {
+		case <-blocker.released:
+		case <-ctx.Done():
+			release()
+			return nil, ctx.Err()
+		}
+	}
+}
+
+// blocker returns the first conflicting lock queued before the given one, nil if it can be acquired
+func (locks *targetLocks) blocker(lock *targetLock) *targetLock {
+	locks.mutex.Lock()
+	defer locks.mutex.Unlock()
+
+	for _, queued := range locks.queue {
+		if queued == lock {
+			return nil
+		}
+		if queued.conflicts(lock) {
+			return queued
+		}
+	}
+	return nil
+}
+
+// commandScheduler bounds the number of commands executed at the same time
+// and makes sure mutating commands targeting the same container do not overlap
+type commandScheduler struct {
+	mutatingSlots chan struct{}
+	readSlots     chan struct{}
+	streamSlots   chan struct{}
+	targets       targetLocks
+	queueDepth    int32
+}
+
+// the queue is logged as a warning above these, the commands are expected to start right away
+const (
+	queueDepthWarning = 16
+	queueWaitWarning  = 10 * time.Second
+)
+
+func newCommandScheduler(appConfig *config.CommonConfiguration) *commandScheduler {
+	return &commandScheduler{
+		mutatingSlots: make(chan struct{}, maxInt(appConfig.CommandConcurrency, 1)),
+		readSlots:     make(chan struct{}, maxInt(appConfig.ReadCommandConcurrency, 1)),
+		streamSlots:   make(chan struct{}, maxInt(appConfig.StreamConcurrency, 1)),
+	}
+}
+
+func maxInt(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
+
+// acquire blocks until the command is allowed to run, returns the function releasing its slot and targets
+func (scheduler *commandScheduler) acquire(ctx context.Context, command *scheduledCommand) (func(), error) {
+	if command.kind == commandKindControl {
+		return func() {}, nil
+	}
+
+	releaseTargets := func() {}
+	if len(command.targets) > 0 {
+		release, err := scheduler.targets.lock(ctx, command.targets)
+		if err != nil {
+			return nil, err
+		}
+		releaseTargets = release
+	}
+
+	slots := scheduler.readSlots
+	switch command.kind {
+	case commandKindMutating:
+		slots = scheduler.mutatingSlots
+	case commandKindStream:
+		slots = scheduler.streamSlots
+	default:
+	}
+
+	select {
+	case slots <- struct{}{}:
+	case <-ctx.Done():
+		releaseTargets()
+		return nil, ctx.Err()
+	}
+
+	return func() {
+		<-slots
+		releaseTargets()
+	}, nil
+}
+
+// run waits for the command to be scheduled then executes it
+func (scheduler *commandScheduler) run(ctx context.Context, command *scheduledCommand) error {
+	queued := time.Now()
+	depth := atomic.AddInt32(&scheduler.queueDepth, 1)
+	metrics.CommandQueueDepth.Inc()
+	queuedLog := log.Debug()
+	if depth > queueDepthWarning {
+		queuedLog = log.Warn()
+	}
+	queuedLog.Str("command", command.name).Int32("queueDepth", depth).Msg("Command queued")
+
+	release, err := scheduler.acquire(ctx, command)
+	depth = atomic.AddInt32(&scheduler.queueDepth, -1)
+	metrics.CommandQueueDepth.Dec()
+	if err != nil {
+		log.Warn().Err(err).Str("command", command.name).Dur("wait", time.Since(queued)).Msg("Command dropped from the queue")
+		return err
+	}
+	defer release()
+
+	wait := time.Since(queued)
+	startedLog := log.Debug()
+	if wait > queueWaitWarning {
+		startedLog = log.Warn()
+	}
+	startedLog.Str("command", command.name).Int32("queueDepth", depth).Dur("wait", wait).Msg("Command started")
+
+	return command.execute()
+}
+
+// PeriodicTask is a job of the agent itself, eg. the image garbage collection, it is scheduled
+// like the commands of crux and conflicts with all of them
+type PeriodicTask struct {
+	Name     string
+	Interval time.Duration
+	Execute  func(context.Context) error
+}
+
+// startPeriodicTasks runs the tasks at their interval until the context is done, a tick is skipped
+// while the previous run of the task is waiting or running
+func (scheduler *commandScheduler) startPeriodicTasks(ctx context.Context, tasks []PeriodicTask) {
+	for i := range tasks {
+		task := tasks[i]
+		go func() {
+			ticker := time.NewTicker(task.Interval)
+			defer ticker.Stop()
+
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case <-ticker.C:
+					err := scheduler.run(ctx, &scheduledCommand{
+						name: task.Name, kind: commandKindMutating, targets: []targetKey{exclusiveTarget},
+						execute: func() error { return task.Execute(ctx) },
+					})
+					if err != nil {
+						log.Error().Err(err).Str("task", task.Name).Msg("Periodic task failed")
+					}
+				}
+			}
+		}()
+	}
+}
+
+func deployTargets(req *agent.VersionDeployRequest) []targetKey {
+	targets := []targetKey{}
+	for _, deployReq := range req.Requests {
+		name := deployReq.GetCommon().GetName()
+		if name == "" {
+			name = deployReq.ContainerName
+		}
+		targets = append(targets, targetKey{prefix: deployReq.GetInstanceConfig().GetPrefix(), name: name})
+	}
+	return targets
+}
+
+func deployLegacyTargets(req *agent.DeployRequestLegacy) []targetKey {
+	deployImageRequest := v1.DeployImageRequest{}
+	if err := json.Unmarshal([]byte(req.Json), &deployImageRequest); err != nil {
+		// the deployment itself reports the parse error
+		return nil
+	}
+
+	return []targetKey{{
+		prefix: deployImageRequest.InstanceConfig.ContainerPreName,
+		name:   deployImageRequest.ContainerConfig.Container,
+	}}
+}
+
+func deleteContainersTargets(req *common.DeleteContainersRequest) []targetKey {
+	if container := req.GetContainer(); container != nil {
+		return []targetKey{{prefix: container.Prefix, name: container.Name}}
+	}
+	return []targetKey{{prefix: req.GetPrefix()}}
+}
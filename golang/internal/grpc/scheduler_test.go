@@ -0,0 +1,288 @@
+//go:build unit
+// +build unit
+
+package grpc_test
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dyrector-io/dyrectorio/golang/internal/config"
+	"github.com/dyrector-io/dyrectorio/golang/internal/grpc"
+)
+
+func testSchedulerConfig() *config.CommonConfiguration {
+	return &config.CommonConfiguration{
+		CommandConcurrency:     4,
+		ReadCommandConcurrency: 2,
+		StreamConcurrency:      3,
+	}
+}
+
+// runConcurrently starts every function at once and returns the highest number of them running at the same time
+func runConcurrently(t *testing.T, run func(i int, execute func() error) error, count int) int32 {
+	var running, maxRunning int32
+	execute := func() error {
+		current := atomic.AddInt32(&running, 1)
+		for {
+			max := atomic.LoadInt32(&maxRunning)
+			if current <= max || atomic.CompareAndSwapInt32(&maxRunning, max, current) {
+				break
+			}
+		}
+		time.Sleep(20 * time.Millisecond)
+		atomic.AddInt32(&running, -1)
+		return nil
+	}
+
+	wg := sync.WaitGroup{}
+	for i := 0; i < count; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			assert.NoError(t, run(i, execute))
+		}(i)
+	}
+	wg.Wait()
+
+	return maxRunning
+}
+
+func TestSchedulerSerializesSameTarget(t *testing.T) {
+	scheduler := grpc.NewCommandSchedulerForTest(testSchedulerConfig())
+
+	maxRunning := runConcurrently(t, func(i int, execute func() error) error {
+		return scheduler.RunMutating(context.Background(), "prefix", "name", execute)
+	}, 4)
+
+	assert.Equal(t, int32(1), maxRunning)
+}
+
+func TestSchedulerRunsDifferentTargetsInParallel(t *testing.T) {
+	scheduler := grpc.NewCommandSchedulerForTest(testSchedulerConfig())
+	names := []string{"a", "b", "c", "d"}
+
+	maxRunning := runConcurrently(t, func(i int, execute func() error) error {
+		return scheduler.RunMutating(context.Background(), "prefix", names[i], execute)
+	}, len(names))
+
+	assert.Greater(t, maxRunning, int32(1))
+}
+
+func TestSchedulerPrefixTargetConflictsWithContainers(t *testing.T) {
+	scheduler := grpc.NewCommandSchedulerForTest(testSchedulerConfig())
+	names := []string{"", "a", ""}
+
+	maxRunning := runConcurrently(t, func(i int, execute func() error) error {
+		return scheduler.RunMutating(context.Background(), "prefix", names[i], execute)
+	}, len(names))
+
+	assert.Equal(t, int32(1), maxRunning)
+}
+
+func TestSchedulerLimitsReads(t *testing.T) {
+	scheduler := grpc.NewCommandSchedulerForTest(testSchedulerConfig())
+
+	maxRunning := runConcurrently(t, func(i int, execute func() error) error {
+		return scheduler.RunRead(context.Background(), execute)
+	}, 6)
+
+	assert.Equal(t, int32(2), maxRunning)
+}
+
+func TestSchedulerLimitsStreams(t *testing.T) {
+	scheduler := grpc.NewCommandSchedulerForTest(testSchedulerConfig())
+
+	maxRunning := runConcurrently(t, func(i int, execute func() error) error {
+		return scheduler.RunStream(context.Background(), execute)
+	}, 6)
+
+	assert.Equal(t, int32(3), maxRunning)
+}
+
+func TestSchedulerKeepsOrderOfSameTarget(t *testing.T) {
+	scheduler := grpc.NewCommandSchedulerForTest(testSchedulerConfig())
+	release := make(chan struct{})
+	started := make(chan struct{})
+	order := make(chan int, 3)
+
+	go func() {
+		_ = scheduler.RunMutating(context.Background(), "prefix", "name", func() error {
+			close(started)
+			<-release
+			order <- 0
+			return nil
+		})
+	}()
+	<-started
+
+	wg := sync.WaitGroup{}
+	for i := 1; i < 3; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			_ = scheduler.RunMutating(context.Background(), "prefix", "name", func() error {
+				order <- i
+				return nil
+			})
+		}(i)
+		// let the command get queued before the next one
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	close(release)
+	wg.Wait()
+	close(order)
+
+	result := []int{}
+	for i := range order {
+		result = append(result, i)
+	}
+	assert.Equal(t, []int{0, 1, 2}, result)
+}
+
+func TestSchedulerDropsCanceledCommand(t *testing.T) {
+	scheduler := grpc.NewCommandSchedulerForTest(testSchedulerConfig())
+	release := make(chan struct{})
+	started := make(chan struct{})
+
+	go func() {
+		_ = scheduler.RunMutating(context.Background(), "prefix", "name", func() error {
+			close(started)
+			<-release
+			return nil
+		})
+	}()
+	<-started
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	executed := false
+	err := scheduler.RunMutating(ctx, "prefix", "name", func() error {
+		executed = true
+		return nil
+	})
+	close(release)
+
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.False(t, executed)
+}
+
+func TestSchedulerStreamsDoNotBlockReads(t *testing.T) {
+	scheduler := grpc.NewCommandSchedulerForTest(testSchedulerConfig())
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// more open streams than read slots
+	started := make(chan struct{}, 3)
+	for i := 0; i < 3; i++ {
+		go func() {
+			_ = scheduler.RunStream(ctx, func() error {
+				started <- struct{}{}
+				<-ctx.Done()
+				return nil
+			})
+		}()
+	}
+	for i := 0; i < 3; i++ {
+		select {
+		case <-started:
+		case <-time.After(time.Second):
+			t.Fatal("stream was not started")
+		}
+	}
+
+	readCtx, readCancel := context.WithTimeout(context.Background(), time.Second)
+	defer readCancel()
+
+	executed := false
+	err := scheduler.RunRead(readCtx, func() error {
+		executed = true
+		return nil
+	})
+
+	assert.NoError(t, err)
+	assert.True(t, executed)
+}
+
+func TestSchedulerExclusiveWaitsForOtherTargets(t *testing.T) {
+	scheduler := grpc.NewCommandSchedulerForTest(testSchedulerConfig())
+	release := make(chan struct{})
+	started := make(chan struct{})
+	go func() {
+		_ = scheduler.RunMutating(context.Background(), "prefix", "deploy", func() error {
+			close(started)
+			<-release
+			return nil
+		})
+	}()
+	<-started
+
+	exclusiveDone := make(chan struct{})
+	go func() {
+		_ = scheduler.RunExclusive(context.Background(), func() error { return nil })
+		close(exclusiveDone)
+	}()
+
+	select {
+	case <-exclusiveDone:
+		t.Fatal("exclusive command ran during a deployment")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	close(release)
+	select {
+	case <-exclusiveDone:
+	case <-time.After(time.Second):
+		t.Fatal("exclusive command did not run after the deployment")
+	}
+}
+
+func TestSchedulerPeriodicTaskWaitsForDeployment(t *testing.T) {
+	scheduler := grpc.NewCommandSchedulerForTest(testSchedulerConfig())
+	release := make(chan struct{})
+	started := make(chan struct{})
+	go func() {
+		_ = scheduler.RunMutating(context.Background(), "prefix", "deploy", func() error {
+			close(started)
+			<-release
+			return nil
+		})
+	}()
+	<-started
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	taskDone := make(chan struct{}, 1)
+	scheduler.StartPeriodicTasks(ctx, []grpc.PeriodicTask{{
+		Name:     "test",
+		Interval: 10 * time.Millisecond,
+		Execute: func(context.Context) error {
+			select {
+			case taskDone <- struct{}{}:
+			default:
+			}
+			return nil
+		},
+	}})
+
+	select {
+	case <-taskDone:
+		t.Fatal("periodic task ran during a deployment")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	close(release)
+	select {
+	case <-taskDone:
+	case <-time.After(time.Second):
+		t.Fatal("periodic task did not run after the deployment")
+	}
+}
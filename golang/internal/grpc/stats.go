@@ -0,0 +1,100 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/dyrector-io/dyrectorio/protobuf/go/agent"
+)
+
+// used if the request has no interval
+const defaultContainerStatsInterval = 5 * time.Second
+
+func containerStatsInterval(req *agent.ContainerStatsRequest) time.Duration {
+	if req.GetIntervalSeconds() == 0 {
+		return defaultContainerStatsInterval
+	}
+
+	return time.Duration(req.GetIntervalSeconds()) * time.Second
+}
+
+// streamContainerStats sends a sample every interval until crux closes the stream
+func streamContainerStats(stream agent.Agent_ContainerStatsClient, req *agent.ContainerStatsRequest,
+	statsFn ContainerStatsFunc, interval time.Duration,
+) error {
+	closed := make(chan struct{})
+	go func() {
+		defer close(closed)
+
+		var msg interface{}
+		// blocks until the server ends the call
+		_ = stream.RecvMsg(&msg)
+	}()
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		stats, err := statsFn(stream.Context(), req)
+		if err != nil {
+			return fmt.Errorf("failed to get container stats: %w", err)
+		}
+
+		err = stream.Send(&agent.ContainerStatsMessage{Timestamp: timestamppb.Now(), Stats: stats})
+		if err != nil {
+			return fmt.Errorf("failed to send container stats: %w", err)
+		}
+
+		select {
+		case <-closed:
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+func executeContainerStats(ctx context.Context, req *agent.ContainerStatsRequest, statsFn ContainerStatsFunc) error {
+	if statsFn == nil {
+		log.Error().Msg("Container stats function not implemented")
+		return errors.New("container stats function not implemented")
+	}
+
+	prefix := req.GetPrefix()
+	name := req.GetName()
+	interval := containerStatsInterval(req)
+
+	log.Debug().Str("prefix", prefix).Str("name", name).Stringer("interval", interval).Msg("Streaming container stats")
+
+	streamCtx := metadata.AppendToOutgoingContext(ctx, "dyo-container-prefix", prefix)
+	if req.Name != nil {
+		streamCtx = metadata.AppendToOutgoingContext(streamCtx, "dyo-container-name", name)
+	}
+
+	stream, err := grpcConn.Client.ContainerStats(streamCtx, grpc.WaitForReady(true))
+	if err != nil {
+		log.Error().Err(err).Str("prefix", prefix).Str("name", name).Msg("Failed to open container stats channel")
+		return fmt.Errorf("failed to open container stats channel: %w", err)
+	}
+
+	defer func() {
+		if closeErr := stream.CloseSend(); closeErr != nil {
+			log.Error().Err(closeErr).Str("prefix", prefix).Str("name", name).Msg("Failed to close container stats stream")
+		}
+	}()
+
+	err = streamContainerStats(stream, req, statsFn, interval)
+	if err != nil {
+		log.Error().Err(err).Str("prefix", prefix).Str("name", name).Msg("Container stats stream failed")
+		return err
+	}
+
+	log.Debug().Str("prefix", prefix).Str("name", name).Msg("Container stats stream closed")
+	return nil
+}
@@ -0,0 +1,79 @@
+//go:build unit
+// +build unit
+
+package grpc_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	grpclib "google.golang.org/grpc"
+
+	"github.com/dyrector-io/dyrectorio/golang/internal/grpc"
+	"github.com/dyrector-io/dyrectorio/protobuf/go/agent"
+	"github.com/dyrector-io/dyrectorio/protobuf/go/common"
+)
+
+type fakeContainerStatsStream struct {
+	grpclib.ClientStream
+	messages chan *agent.ContainerStatsMessage
+	closed   chan struct{}
+}
+
+func (stream *fakeContainerStatsStream) Context() context.Context {
+	return context.Background()
+}
+
+func (stream *fakeContainerStatsStream) Send(message *agent.ContainerStatsMessage) error {
+	select {
+	case stream.messages <- message:
+	default:
+		// the test stopped reading
+	}
+	return nil
+}
+
+func (stream *fakeContainerStatsStream) CloseAndRecv() (*common.Empty, error) {
+	return &common.Empty{}, nil
+}
+
+func (stream *fakeContainerStatsStream) RecvMsg(interface{}) error {
+	<-stream.closed
+	return errors.New("closed by the server")
+}
+
+func TestStreamContainerStatsUntilClosed(t *testing.T) {
+	stream := &fakeContainerStatsStream{messages: make(chan *agent.ContainerStatsMessage, 1), closed: make(chan struct{})}
+	sample := []*agent.ContainerStats{{Container: &common.ContainerIdentifier{Prefix: "prefix", Name: "name"}, CpuPercent: 12.5}}
+	statsFn := func(context.Context, *agent.ContainerStatsRequest) ([]*agent.ContainerStats, error) {
+		return sample, nil
+	}
+
+	result := make(chan error)
+	go func() {
+		result <- grpc.StreamContainerStatsForTest(stream, &agent.ContainerStatsRequest{Prefix: "prefix"}, statsFn, time.Millisecond)
+	}()
+
+	for i := 0; i < 3; i++ {
+		message := <-stream.messages
+		assert.Equal(t, sample, message.Stats)
+		assert.NotNil(t, message.Timestamp)
+	}
+
+	close(stream.closed)
+	assert.NoError(t, <-result)
+}
+
+func TestStreamContainerStatsError(t *testing.T) {
+	stream := &fakeContainerStatsStream{messages: make(chan *agent.ContainerStatsMessage), closed: make(chan struct{})}
+	statsFn := func(context.Context, *agent.ContainerStatsRequest) ([]*agent.ContainerStats, error) {
+		return nil, errors.New("docker is unreachable")
+	}
+
+	err := grpc.StreamContainerStatsForTest(stream, &agent.ContainerStatsRequest{}, statsFn, time.Millisecond)
+
+	assert.ErrorContains(t, err, "docker is unreachable")
+}
@@ -0,0 +1,137 @@
+package grpc
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/rs/zerolog/log"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"github.com/dyrector-io/dyrectorio/golang/internal/config"
+)
+
+var errPinMismatch = errors.New("none of the server certificates match the pinned public keys")
+
+// explicitTLS is true if any of the TLS options is configured, then the certificates are never fetched from the server
+func explicitTLS(appConfig *config.CommonConfiguration) bool {
+	return appConfig.GrpcCACertFile != "" ||
+		appConfig.GrpcClientCertFile != "" ||
+		appConfig.GrpcClientKeyFile != "" ||
+		strings.TrimSpace(strings.Join(appConfig.GrpcPinnedSPKI, "")) != ""
+}
+
+// transportCredentials returns the credentials of the crux connection, without explicit TLS options
+// the certificates presented by the server are trusted, plain-text is allowed in debug mode
+func transportCredentials(ctx context.Context, address string, appConfig *config.CommonConfiguration) (
+	credentials.TransportCredentials, error,
+) {
+	if explicitTLS(appConfig) {
+		tlsConfig, err := newTLSConfig(appConfig)
+		if err != nil {
+			return nil, err
+		}
+		return credentials.NewTLS(tlsConfig), nil
+	}
+
+	certPool, err := fetchCertificatesFromURL(ctx, fmt.Sprintf("https://%s", address))
+	if err != nil {
+		if appConfig.Debug {
+			log.Warn().Err(err).Msg("Secure mode is disabled in demo/dev environment, falling back to plain-text gRPC")
+			return insecure.NewCredentials(), nil
+		}
+		return nil, fmt.Errorf("could not fetch valid certificate: %w", err)
+	}
+
+	return credentials.NewClientTLSFromCert(certPool, ""), nil
+}
+
+func newTLSConfig(appConfig *config.CommonConfiguration) (*tls.Config, error) {
+	tlsConfig := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+	}
+
+	if appConfig.GrpcCACertFile != "" {
+		pem, err := os.ReadFile(appConfig.GrpcCACertFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read the CA bundle: %w", err)
+		}
+
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no certificates found in the CA bundle: %s", appConfig.GrpcCACertFile)
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	if (appConfig.GrpcClientCertFile == "") != (appConfig.GrpcClientKeyFile == "") {
+		return nil, errors.New("the client certificate and key must be set together")
+	}
+
+	if appConfig.GrpcClientCertFile != "" {
+		cert, err := tls.LoadX509KeyPair(appConfig.GrpcClientCertFile, appConfig.GrpcClientKeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load the client certificate: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	if strings.TrimSpace(strings.Join(appConfig.GrpcPinnedSPKI, "")) != "" {
+		pins, err := parseSPKIPins(appConfig.GrpcPinnedSPKI)
+		if err != nil {
+			return nil, err
+		}
+		tlsConfig.VerifyConnection = func(state tls.ConnectionState) error {
+			return verifySPKIPins(state.PeerCertificates, pins)
+		}
+	}
+
+	return tlsConfig, nil
+}
+
+// parseSPKIPins decodes the base64 encoded SHA-256 hashes of the pinned public keys, the 'sha256/' prefix is optional
+func parseSPKIPins(encoded []string) (map[[sha256.Size]byte]bool, error) {
+	pins := map[[sha256.Size]byte]bool{}
+	for _, it := range encoded {
+		it = strings.TrimPrefix(strings.TrimSpace(it), "sha256/")
+		if it == "" {
+			continue
+		}
+
+		hash, err := base64.StdEncoding.DecodeString(it)
+		if err != nil {
+			return nil, fmt.Errorf("invalid SPKI pin %q: %w", it, err)
+		}
+		if len(hash) != sha256.Size {
+			return nil, fmt.Errorf("invalid SPKI pin %q: not a SHA-256 hash", it)
+		}
+
+		var pin [sha256.Size]byte
+		copy(pin[:], hash)
+		pins[pin] = true
+	}
+
+	if len(pins) == 0 {
+		return nil, errors.New("no SPKI pins were given")
+	}
+
+	return pins, nil
+}
+
+// verifySPKIPins succeeds if the public key of any certificate in the chain is pinned,
+// the chain itself is verified by the TLS stack beforehand
+func verifySPKIPins(certificates []*x509.Certificate, pins map[[sha256.Size]byte]bool) error {
+	for _, cert := range certificates {
+		if pins[sha256.Sum256(cert.RawSubjectPublicKeyInfo)] {
+			return nil
+		}
+	}
+	return errPinMismatch
+}
@@ -0,0 +1,96 @@
+//go:build unit
+// +build unit
+
+package grpc_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/pem"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dyrector-io/dyrectorio/golang/internal/config"
+	"github.com/dyrector-io/dyrectorio/golang/internal/grpc"
+)
+
+func newTestCertificate(t *testing.T) *x509.Certificate {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "crux"},
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(time.Hour),
+		IsCA:         true,
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	assert.NoError(t, err)
+
+	cert, err := x509.ParseCertificate(der)
+	assert.NoError(t, err)
+	return cert
+}
+
+func spkiPin(cert *x509.Certificate) string {
+	hash := sha256.Sum256(cert.RawSubjectPublicKeyInfo)
+	return base64.StdEncoding.EncodeToString(hash[:])
+}
+
+func TestExplicitTLS(t *testing.T) {
+	assert.False(t, grpc.ExplicitTLSForTest(&config.CommonConfiguration{}))
+	assert.False(t, grpc.ExplicitTLSForTest(&config.CommonConfiguration{GrpcPinnedSPKI: []string{""}}))
+	assert.True(t, grpc.ExplicitTLSForTest(&config.CommonConfiguration{GrpcCACertFile: "ca.pem"}))
+	assert.True(t, grpc.ExplicitTLSForTest(&config.CommonConfiguration{GrpcPinnedSPKI: []string{"pin"}}))
+}
+
+func TestTLSConfigCABundle(t *testing.T) {
+	cert := newTestCertificate(t)
+
+	caFile := filepath.Join(t.TempDir(), "ca.pem")
+	assert.NoError(t, os.WriteFile(caFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert.Raw}), 0o600))
+
+	tlsConfig, err := grpc.NewTLSConfigForTest(&config.CommonConfiguration{GrpcCACertFile: caFile})
+	assert.NoError(t, err)
+	assert.NotNil(t, tlsConfig.RootCAs)
+
+	_, err = grpc.NewTLSConfigForTest(&config.CommonConfiguration{GrpcCACertFile: filepath.Join(t.TempDir(), "missing.pem")})
+	assert.Error(t, err)
+}
+
+func TestTLSConfigClientCertificateWithoutKey(t *testing.T) {
+	_, err := grpc.NewTLSConfigForTest(&config.CommonConfiguration{GrpcClientCertFile: "client.pem"})
+	assert.Error(t, err)
+}
+
+func TestTLSConfigPinning(t *testing.T) {
+	pinned := newTestCertificate(t)
+	other := newTestCertificate(t)
+
+	tlsConfig, err := grpc.NewTLSConfigForTest(&config.CommonConfiguration{GrpcPinnedSPKI: []string{"sha256/" + spkiPin(pinned)}})
+	assert.NoError(t, err)
+
+	assert.NoError(t, tlsConfig.VerifyConnection(tls.ConnectionState{PeerCertificates: []*x509.Certificate{other, pinned}}))
+	assert.Error(t, tlsConfig.VerifyConnection(tls.ConnectionState{PeerCertificates: []*x509.Certificate{other}}))
+}
+
+func TestTLSConfigInvalidPin(t *testing.T) {
+	_, err := grpc.NewTLSConfigForTest(&config.CommonConfiguration{GrpcPinnedSPKI: []string{"not-base64!"}})
+	assert.Error(t, err)
+
+	_, err = grpc.NewTLSConfigForTest(&config.CommonConfiguration{GrpcPinnedSPKI: []string{base64.StdEncoding.EncodeToString([]byte("short"))}})
+	assert.Error(t, err)
+}
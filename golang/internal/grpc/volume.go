@@ -0,0 +1,173 @@
+package grpc
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/rs/zerolog/log"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/dyrector-io/dyrectorio/golang/internal/dogger"
+	"github.com/dyrector-io/dyrectorio/golang/internal/tracing"
+	"github.com/dyrector-io/dyrectorio/protobuf/go/agent"
+)
+
+// size of the chunks sent to crux
+const chunkSize = 64 * 1024
+
+// chunkSender splits the written data into the messages of a stream
+type chunkSender struct {
+	send func(data []byte) error
+}
+
+func (sender *chunkSender) Write(p []byte) (int, error) {
+	// the stream may hold on to the message, the buffer is reused by the caller
+	data := make([]byte, len(p))
+	copy(data, p)
+
+	if err := sender.send(data); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+// chunkReceiver reads the data from the messages of a stream
+type chunkReceiver struct {
+	recv    func() ([]byte, error)
+	pending []byte
+}
+
+func (receiver *chunkReceiver) Read(p []byte) (int, error) {
+	for len(receiver.pending) == 0 {
+		data, err := receiver.recv()
+		if err != nil {
+			return 0, err
+		}
+		receiver.pending = data
+	}
+
+	n := copy(p, receiver.pending)
+	receiver.pending = receiver.pending[n:]
+	return n, nil
+}
+
+// openVolumeOperationStatus opens the progress stream of a volume operation
+func openVolumeOperationStatus(ctx context.Context, operationID string) (context.Context, *dogger.VolumeOperationLogger,
+	agent.Agent_VolumeOperationStatusClient, error,
+) {
+	operationCtx := tracing.AppendToOutgoingContext(metadata.AppendToOutgoingContext(ctx, "dyo-volume-operation-id", operationID))
+	statusStream, err := grpcConn.Client.VolumeOperationStatus(operationCtx, grpc.WaitForReady(true))
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("status connect error: %w", err)
+	}
+
+	return operationCtx, dogger.NewVolumeOperationLogger(operationID, statusStream), statusStream, nil
+}
+
+// closeVolumeOperationStatus reports the outcome of the operation and closes the progress stream
+func closeVolumeOperationStatus(volLog *dogger.VolumeOperationLogger, statusStream agent.Agent_VolumeOperationStatusClient,
+	operationID string, operationErr error,
+) {
+	if operationErr != nil {
+		volLog.WriteStatus(agent.VolumeOperationStatus_VOLUME_OPERATION_FAILED, "Failed: "+operationErr.Error())
+	} else {
+		volLog.WriteStatus(agent.VolumeOperationStatus_VOLUME_OPERATION_SUCCESSFUL, "Finished.")
+	}
+
+	if _, err := statusStream.CloseAndRecv(); err != nil && !errors.Is(err, io.EOF) {
+		log.Error().Err(err).Str("volumeOperation", operationID).Msg("Failed to close the volume operation status stream")
+	}
+}
+
+func executeVolumeBackup(ctx context.Context, req *agent.VolumeBackupRequest, backupFn VolumeBackupFunc) (err error) {
+	if backupFn == nil {
+		log.Error().Msg("Volume backup function not implemented")
+		return errors.New("volume backup function not implemented")
+	}
+
+	if req.GetId() == "" {
+		return errors.New("empty request id for volume backup")
+	}
+
+	ctx, span := tracing.Start(ctx, "volume backup", tracing.ContainerKey.String(req.GetContainer().GetName()))
+	defer func() { tracing.End(span, err) }()
+
+	operationCtx, volLog, statusStream, err := openVolumeOperationStatus(ctx, req.GetId())
+	if err != nil {
+		return err
+	}
+	defer func() { closeVolumeOperationStatus(volLog, statusStream, req.GetId(), err) }()
+
+	volLog.WriteStatus(agent.VolumeOperationStatus_VOLUME_OPERATION_IN_PROGRESS, "Started volume backup: "+req.GetVolume())
+
+	if req.Remote != nil {
+		return backupFn(ctx, volLog, req, nil)
+	}
+
+	archiveStream, err := grpcConn.Client.VolumeBackupArchive(operationCtx, grpc.WaitForReady(true))
+	if err != nil {
+		return fmt.Errorf("archive connect error: %w", err)
+	}
+
+	archive := bufio.NewWriterSize(&chunkSender{send: func(data []byte) error {
+		return archiveStream.Send(&agent.VolumeArchiveChunk{Data: data})
+	}}, chunkSize)
+	if err = backupFn(ctx, volLog, req, archive); err != nil {
+		// crux discards the incomplete archive
+		if closeErr := archiveStream.CloseSend(); closeErr != nil {
+			log.Warn().Err(closeErr).Str("volumeOperation", req.GetId()).Msg("Failed to close the archive stream")
+		}
+		return err
+	}
+
+	if err = archive.Flush(); err != nil {
+		return fmt.Errorf("archive upload error: %w", err)
+	}
+
+	if _, err = archiveStream.CloseAndRecv(); err != nil {
+		return fmt.Errorf("archive upload error: %w", err)
+	}
+
+	return nil
+}
+
+func executeVolumeRestore(ctx context.Context, req *agent.VolumeRestoreRequest, restoreFn VolumeRestoreFunc) (err error) {
+	if restoreFn == nil {
+		log.Error().Msg("Volume restore function not implemented")
+		return errors.New("volume restore function not implemented")
+	}
+
+	if req.GetId() == "" {
+		return errors.New("empty request id for volume restore")
+	}
+
+	ctx, span := tracing.Start(ctx, "volume restore", tracing.ContainerKey.String(req.GetContainer().GetName()))
+	defer func() { tracing.End(span, err) }()
+
+	operationCtx, volLog, statusStream, err := openVolumeOperationStatus(ctx, req.GetId())
+	if err != nil {
+		return err
+	}
+	defer func() { closeVolumeOperationStatus(volLog, statusStream, req.GetId(), err) }()
+
+	volLog.WriteStatus(agent.VolumeOperationStatus_VOLUME_OPERATION_IN_PROGRESS, "Started volume restore: "+req.GetVolume())
+
+	if req.Remote != nil {
+		return restoreFn(ctx, volLog, req, nil)
+	}
+
+	archiveStream, err := grpcConn.Client.VolumeRestoreArchive(operationCtx, &agent.VolumeArchiveRequest{Id: req.GetId()},
+		grpc.WaitForReady(true))
+	if err != nil {
+		return fmt.Errorf("archive connect error: %w", err)
+	}
+
+	return restoreFn(ctx, volLog, req, &chunkReceiver{recv: func() ([]byte, error) {
+		chunk, err := archiveStream.Recv()
+		return chunk.GetData(), err
+	}})
+}
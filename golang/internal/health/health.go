@@ -0,0 +1,175 @@
+// Package health implements the gRPC health checking protocol for the agents,
+// the status reflects the connection to crux and the dependencies of the agent.
+package health
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"sort"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	grpclib "google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/dyrector-io/dyrectorio/golang/internal/config"
+	"github.com/dyrector-io/dyrectorio/golang/internal/grpc"
+	"github.com/dyrector-io/dyrectorio/protobuf/go/health"
+)
+
+const (
+	// ServiceCrux is serving while the command stream towards crux is up
+	ServiceCrux = "crux"
+	// ServiceKey is serving if the secret private key of the agent is valid
+	ServiceKey = "key"
+	// ServiceDocker is serving if dagent can reach the Docker daemon
+	ServiceDocker = "docker"
+	// ServiceKubernetes is serving if crane can reach the Kubernetes API
+	ServiceKubernetes = "kubernetes"
+)
+
+// the status of a Watch is checked at least this often, connection state changes are sent immediately
+const watchInterval = 5 * time.Second
+
+// CheckFunc returns an error if the checked dependency is not usable
+type CheckFunc func(context.Context) error
+
+// Server implements the gRPC health service of the agents
+type Server struct {
+	health.UnimplementedHealthServer
+	checks  map[string]CheckFunc
+	timeout time.Duration
+}
+
+// NewServer creates a health server checking the crux connection, the key and the given agent specific checks,
+// the empty service name stands for all of them
+func NewServer(appConfig *config.CommonConfiguration, checks map[string]CheckFunc) *Server {
+	server := &Server{
+		checks: map[string]CheckFunc{
+			ServiceCrux: checkCruxConnection,
+			ServiceKey: func(context.Context) error {
+				_, err := config.GetPublicKey(appConfig.SecretPrivateKey)
+				return err
+			},
+		},
+		timeout: appConfig.DefaultTimeout,
+	}
+
+	for name, check := range checks {
+		server.checks[name] = check
+	}
+
+	return server
+}
+
+func checkCruxConnection(context.Context) error {
+	state := grpc.GetConnectionState()
+	if state.State == grpc.ConnectionStateConnected {
+		return nil
+	}
+
+	if state.Error != nil {
+		return fmt.Errorf("crux connection is %s: %w", state.State, state.Error)
+	}
+	return fmt.Errorf("crux connection is %s", state.State)
+}
+
+func (server *Server) runCheck(ctx context.Context, service string) health.HealthCheckResponse_ServingStatus {
+	if server.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, server.timeout)
+		defer cancel()
+	}
+
+	if err := server.checks[service](ctx); err != nil {
+		log.Debug().Err(err).Str("service", service).Msg("Health check failed")
+		return health.HealthCheckResponse_NOT_SERVING
+	}
+	return health.HealthCheckResponse_SERVING
+}
+
+func (server *Server) servingStatus(ctx context.Context, service string) (health.HealthCheckResponse_ServingStatus, error) {
+	if service != "" {
+		if _, ok := server.checks[service]; !ok {
+			return health.HealthCheckResponse_SERVICE_UNKNOWN, status.Errorf(codes.NotFound, "unknown service: %s", service)
+		}
+		return server.runCheck(ctx, service), nil
+	}
+
+	services := []string{}
+	for name := range server.checks {
+		services = append(services, name)
+	}
+	sort.Strings(services)
+
+	for _, name := range services {
+		if server.runCheck(ctx, name) != health.HealthCheckResponse_SERVING {
+			return health.HealthCheckResponse_NOT_SERVING, nil
+		}
+	}
+	return health.HealthCheckResponse_SERVING, nil
+}
+
+func (server *Server) Check(ctx context.Context, req *health.HealthCheckRequest) (*health.HealthCheckResponse, error) {
+	servingStatus, err := server.servingStatus(ctx, req.Service)
+	if err != nil {
+		return nil, err
+	}
+
+	return &health.HealthCheckResponse{Status: servingStatus}, nil
+}
+
+// Watch sends the status of the service whenever it changes, until the client goes away
+func (server *Server) Watch(req *health.HealthCheckRequest, stream health.Health_WatchServer) error {
+	ctx := stream.Context()
+
+	connectionStates, unsubscribe := grpc.SubscribeConnectionState()
+	defer unsubscribe()
+
+	ticker := time.NewTicker(watchInterval)
+	defer ticker.Stop()
+
+	var last *health.HealthCheckResponse_ServingStatus
+	for {
+		// unknown services are reported in the stream instead of failing it, they might show up later
+		servingStatus, _ := server.servingStatus(ctx, req.Service)
+		if last == nil || *last != servingStatus {
+			if err := stream.Send(&health.HealthCheckResponse{Status: servingStatus}); err != nil {
+				return err
+			}
+			last = &servingStatus
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		case <-connectionStates:
+		}
+	}
+}
+
+// Serve listens on the address and serves the health checks until the context is done
+func Serve(ctx context.Context, address string, server *Server) error {
+	listener, err := net.Listen("tcp", address)
+	if err != nil {
+		return fmt.Errorf("health check listen error: %w", err)
+	}
+
+	grpcServer := grpclib.NewServer()
+	health.RegisterHealthServer(grpcServer, server)
+
+	go func() {
+		<-ctx.Done()
+		grpcServer.GracefulStop()
+	}()
+
+	log.Info().Str("address", listener.Addr().String()).Msg("Serving health checks")
+	if err = grpcServer.Serve(listener); err != nil && !errors.Is(err, grpclib.ErrServerStopped) {
+		return fmt.Errorf("health check server error: %w", err)
+	}
+	return nil
+}
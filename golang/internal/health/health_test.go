@@ -0,0 +1,46 @@
+//go:build unit
+// +build unit
+
+package health_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/dyrector-io/dyrectorio/golang/internal/config"
+	"github.com/dyrector-io/dyrectorio/golang/internal/health"
+	protoHealth "github.com/dyrector-io/dyrectorio/protobuf/go/health"
+)
+
+func TestCheckUnknownService(t *testing.T) {
+	server := health.NewServer(&config.CommonConfiguration{}, nil)
+
+	_, err := server.Check(context.Background(), &protoHealth.HealthCheckRequest{Service: "unknown"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestCheckAgentSpecificService(t *testing.T) {
+	server := health.NewServer(&config.CommonConfiguration{}, map[string]health.CheckFunc{
+		health.ServiceDocker: func(context.Context) error { return errors.New("daemon is down") },
+	})
+
+	res, err := server.Check(context.Background(), &protoHealth.HealthCheckRequest{Service: health.ServiceDocker})
+	assert.NoError(t, err)
+	assert.Equal(t, protoHealth.HealthCheckResponse_NOT_SERVING, res.Status)
+}
+
+func TestCheckAllServices(t *testing.T) {
+	server := health.NewServer(&config.CommonConfiguration{}, map[string]health.CheckFunc{
+		health.ServiceDocker: func(context.Context) error { return nil },
+	})
+
+	// there is no crux connection and no key in the tests
+	res, err := server.Check(context.Background(), &protoHealth.HealthCheckRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, protoHealth.HealthCheckResponse_NOT_SERVING, res.Status)
+}
@@ -101,6 +101,10 @@ func mapContainerConfig(in *agent.DeployRequest) v1.ContainerConfig {
 		containerConfig.Args = cc.Args
 	}
 
+	if cc.PullPolicy != nil {
+		containerConfig.PullPolicy = mapPullPolicy(*cc.PullPolicy)
+	}
+
 	if cc.Commands != nil {
 		containerConfig.Command = cc.Commands
 	}
@@ -157,6 +161,22 @@ func mapDagentConfig(dagent *agent.DagentContainerConfig, containerConfig *v1.Co
 	if dagent.Labels != nil {
 		containerConfig.DockerLabels = dagent.Labels
 	}
+
+	if dagent.SecretFiles != nil {
+		containerConfig.SecretFiles = dagent.SecretFiles
+	}
+
+	if dagent.DeploymentStrategy != nil {
+		containerConfig.DeploymentStrategy = dagent.DeploymentStrategy.String()
+	}
+
+	if dagent.HealthCheckConfig != nil {
+		containerConfig.HealthCheckConfig = mapHealthCheckConfig(dagent.HealthCheckConfig)
+	}
+
+	if dagent.ResourceConfig != nil {
+		containerConfig.ResourceConfig = mapResourceConfig(dagent.ResourceConfig)
+	}
 }
 
 func mapCraneConfig(crane *agent.CraneContainerConfig, containerConfig *v1.ContainerConfig) {
@@ -199,6 +219,19 @@ func mapCraneConfig(crane *agent.CraneContainerConfig, containerConfig *v1.Conta
 	}
 }
 
+func mapPullPolicy(policy common.PullPolicy) builder.PullPolicy {
+	switch policy {
+	case common.PullPolicy_PULL_POLICY_ALWAYS:
+		return builder.AlwaysPullPolicy
+	case common.PullPolicy_PULL_POLICY_IF_NOT_PRESENT:
+		return builder.IfNotPresentPullPolicy
+	case common.PullPolicy_PULL_POLICY_NEVER:
+		return builder.NeverPullPolicy
+	default:
+		return builder.EmptyPullPolicy
+	}
+}
+
 func mapRestartPolicy(policy string) builder.RestartPolicyName {
 	lower := strings.ToLower(policy)
 
@@ -377,17 +410,7 @@ func MapContainerState(in []dockerTypes.Container, prefix string) []*common.Cont
 			name = strings.TrimPrefix(name, prefix+"-")
 		}
 
-		imageName := strings.Split(it.Image, ":")
-
-		var imageTag string
-
-		if len(imageName) > 1 {
-			imageTag = imageName[1]
-		} else {
-			imageTag = "latest"
-		}
-
-		list = append(list, &common.ContainerStateItem{
+		stateItem := &common.ContainerStateItem{
 			Id: &common.ContainerIdentifier{
 				Prefix: prefix,
 				Name:   name,
@@ -397,9 +420,20 @@ func MapContainerState(in []dockerTypes.Container, prefix string) []*common.Cont
 			State:     dogger.MapContainerState(it.State),
 			Status:    it.Status,
 			Ports:     mapContainerPorts(&it.Ports),
-			ImageName: imageName[0],
-			ImageTag:  imageTag,
-		})
+		}
+
+		// the image is its id if the tag was moved to another image since the container was created
+		imageName, imageTag, imageDigest, err := imageHelper.SplitImageReference(it.Image)
+		if err != nil {
+			imageName = it.Image
+		}
+		stateItem.ImageName = imageName
+		stateItem.ImageTag = imageTag
+		if imageDigest != "" {
+			stateItem.ImageDigest = &imageDigest
+		}
+
+		list = append(list, stateItem)
 	}
 
 	return list
@@ -447,20 +481,17 @@ func MapKubeDeploymentListToCruxStateItems(deployments *appsv1.DeploymentList, s
 				}
 				stateItem.Command = util.JoinV(" ", containers[i].Command...)
 
-				fullName, err := imageHelper.ExpandImageName(containers[i].Image)
+				name, tag, imageDigest, err := imageHelper.SplitImageReference(containers[i].Image)
 				if err != nil {
 					log.Error().Stack().Err(err).Msg("Failed to get k8s container image info (failed to parse image name)")
 					continue
 				}
 
-				name, tag, err := imageHelper.SplitImageName(fullName)
-				if err != nil {
-					log.Error().Stack().Err(err).Msg("Failed to get k8s container image info")
-					continue
-				}
-
 				stateItem.ImageName = name
 				stateItem.ImageTag = tag
+				if imageDigest != "" {
+					stateItem.ImageDigest = &imageDigest
+				}
 			}
 		}
 
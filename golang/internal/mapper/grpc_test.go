@@ -7,6 +7,7 @@
 	"time"
 
 	"github.com/AlekSi/pointer"
+	"github.com/docker/docker/api/types"
 	"github.com/docker/docker/api/types/container"
 	"github.com/dyrector-io/dyrectorio/golang/internal/config"
 	"github.com/dyrector-io/dyrectorio/golang/internal/mapper"
@@ -84,10 +85,11 @@ func testExpectedCommon(req *agent.DeployRequest) *v1.DeployImageRequest {
 					Envs:      map[string]string{"env1": "val1", "env2": "val2"},
 				},
 			},
-			User:    req.GetCommon().User,
-			Command: []string{"make", "test"},
-			Args:    []string{"--name", "test-arg"},
-			TTY:     true,
+			User:       req.GetCommon().User,
+			Command:    []string{"make", "test"},
+			Args:       []string{"--name", "test-arg"},
+			TTY:        true,
+			PullPolicy: builder.AlwaysPullPolicy,
 			LogConfig: &container.LogConfig{
 				Type:   "365",
 				Config: map[string]string{"opt1": "v1", "opt2": "v2"},
@@ -156,6 +158,29 @@ func TestMapPorts(t *testing.T) {
 	assert.Equal(t, expected, bindings)
 }
 
+func TestMapContainerStateImage(t *testing.T) {
+	digest := "sha256:4c0fdaa8b6341bfdeca5f18f7837462c80cff90527ee35ef185571e1c327beac"
+	containers := []types.Container{
+		{Names: []string{"/prefix-registry-port"}, Image: "localhost:5000/test/app:1.0"},
+		{Names: []string{"/prefix-pinned"}, Image: "docker.io/library/nginx:latest@" + digest},
+		{Names: []string{"/prefix-image-id"}, Image: digest},
+	}
+
+	items := mapper.MapContainerState(containers, "prefix")
+
+	assert.Equal(t, "registry-port", items[0].Id.Name)
+	assert.Equal(t, "localhost:5000/test/app", items[0].ImageName)
+	assert.Equal(t, "1.0", items[0].ImageTag)
+	assert.Nil(t, items[0].ImageDigest)
+
+	assert.Equal(t, "docker.io/library/nginx", items[1].ImageName)
+	assert.Equal(t, "latest", items[1].ImageTag)
+	assert.Equal(t, digest, items[1].GetImageDigest())
+
+	assert.Equal(t, digest, items[2].ImageName)
+	assert.Empty(t, items[2].ImageTag)
+}
+
 func TestMapSecrets(t *testing.T) {
 	kvl := testKeyValueList()
 
@@ -194,6 +219,7 @@ func testDeployRequest() *agent.DeployRequest {
 			Environment: []string{"ENV1", "VAL1", "ENV2", "VAL2"},
 			Secrets:     map[string]string{"secret1": "value1"},
 			TTY:         &b,
+			PullPolicy:  common.PullPolicy_PULL_POLICY_ALWAYS.Enum(),
 			Ports: []*agent.Port{
 				{
 					Internal: 1234,
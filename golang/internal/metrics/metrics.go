@@ -0,0 +1,121 @@
+// Package metrics holds the Prometheus metrics of the agents, the deployment, image pull and
+// connection code paths update them, the endpoint serving them is opt-in.
+package metrics
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/rs/zerolog/log"
+)
+
+const namespace = "dyo_agent"
+
+// deploy steps measured by DeployStepDuration
+const (
+	StepPull     = "pull"
+	StepCreate   = "create"
+	StepStart    = "start"
+	StepK8sApply = "k8s_apply"
+)
+
+var (
+	DeploymentsStarted = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "deployments_started_total",
+		Help:      "Number of deployments started.",
+	})
+	DeploymentsSucceeded = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "deployments_succeeded_total",
+		Help:      "Number of deployments finished successfully.",
+	})
+	DeploymentsFailed = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "deployments_failed_total",
+		Help:      "Number of failed deployments.",
+	})
+	DeploymentsCanceled = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "deployments_canceled_total",
+		Help:      "Number of canceled deployments.",
+	})
+	DeployStepDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "deploy_step_duration_seconds",
+		Help:      "Duration of the deploy steps: pull, create, start and k8s_apply.",
+		Buckets:   []float64{0.1, 0.5, 1, 2.5, 5, 10, 30, 60, 120, 300, 600},
+	}, []string{"step"})
+	ImagePullBytes = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "image_pull_bytes_total",
+		Help:      "Number of image layer bytes downloaded.",
+	})
+	Reconnects = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "reconnects_total",
+		Help:      "Number of times the command stream towards crux was reconnected.",
+	})
+	ConnectionState = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "connection_state",
+		Help:      "State of the command stream towards crux, the current state is 1, the others are 0.",
+	}, []string{"state"})
+	ActiveLogStreams = promauto.NewGauge(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "active_log_streams",
+		Help:      "Number of container log streams open towards crux.",
+	})
+	CommandQueueDepth = promauto.NewGauge(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "command_queue_depth",
+		Help:      "Number of commands waiting for a free slot or for their targets.",
+	})
+)
+
+// ObserveDeployStep records the time elapsed since start for the given deploy step
+func ObserveDeployStep(step string, start time.Time) {
+	DeployStepDuration.WithLabelValues(step).Observe(time.Since(start).Seconds())
+}
+
+// SetConnectionState sets the gauge of the current state to 1 and the others to 0
+func SetConnectionState(current string, states []string) {
+	for _, state := range states {
+		value := 0.0
+		if state == current {
+			value = 1
+		}
+		ConnectionState.WithLabelValues(state).Set(value)
+	}
+}
+
+// Serve exposes the metrics on the /metrics path of the address until the context is done
+func Serve(ctx context.Context, address string, readHeaderTimeout time.Duration) error {
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.Handler())
+
+	server := &http.Server{
+		Addr:              address,
+		Handler:           mux,
+		ReadHeaderTimeout: readHeaderTimeout,
+	}
+
+	go func() {
+		<-ctx.Done()
+		if err := server.Shutdown(context.Background()); err != nil {
+			log.Error().Err(err).Msg("Failed to shut down the metrics server")
+		}
+	}()
+
+	log.Info().Str("address", address).Msg("Serving metrics")
+	if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return fmt.Errorf("metrics server error: %w", err)
+	}
+	return nil
+}
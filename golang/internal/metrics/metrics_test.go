@@ -0,0 +1,55 @@
+//go:build unit
+// +build unit
+
+package metrics_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dyrector-io/dyrectorio/golang/internal/config"
+	"github.com/dyrector-io/dyrectorio/golang/internal/dogger"
+	"github.com/dyrector-io/dyrectorio/golang/internal/metrics"
+	"github.com/dyrector-io/dyrectorio/protobuf/go/common"
+)
+
+func TestDeploymentLoggerCountsEveryDeploymentOnce(t *testing.T) {
+	started := testutil.ToFloat64(metrics.DeploymentsStarted)
+	failed := testutil.ToFloat64(metrics.DeploymentsFailed)
+	succeeded := testutil.ToFloat64(metrics.DeploymentsSucceeded)
+
+	dog := dogger.NewDeploymentLogger(context.Background(), nil, nil, &config.CommonConfiguration{})
+	dog.WriteDeploymentStatus(common.DeploymentStatus_IN_PROGRESS, "Started.")
+	dog.WriteDeploymentStatus(common.DeploymentStatus_IN_PROGRESS, "Loading assets was successful.")
+	dog.WriteDeploymentStatus(common.DeploymentStatus_FAILED, "Failed to spawn init container")
+	dog.WriteDeploymentStatus(common.DeploymentStatus_FAILED)
+
+	assert.Equal(t, started+1, testutil.ToFloat64(metrics.DeploymentsStarted))
+	assert.Equal(t, failed+1, testutil.ToFloat64(metrics.DeploymentsFailed))
+	assert.Equal(t, succeeded, testutil.ToFloat64(metrics.DeploymentsSucceeded))
+}
+
+func TestDeploymentLoggerCountsCanceledDeployments(t *testing.T) {
+	started := testutil.ToFloat64(metrics.DeploymentsStarted)
+	failed := testutil.ToFloat64(metrics.DeploymentsFailed)
+	canceled := testutil.ToFloat64(metrics.DeploymentsCanceled)
+
+	dog := dogger.NewDeploymentLogger(context.Background(), nil, nil, &config.CommonConfiguration{})
+	dog.WriteDeploymentStatus(common.DeploymentStatus_IN_PROGRESS, "Started.")
+	dog.WriteDeploymentStatus(common.DeploymentStatus_OBSOLETE, "Deployment was canceled.")
+	dog.WriteDeploymentStatus(common.DeploymentStatus_FAILED)
+
+	assert.Equal(t, started+1, testutil.ToFloat64(metrics.DeploymentsStarted))
+	assert.Equal(t, canceled+1, testutil.ToFloat64(metrics.DeploymentsCanceled))
+	assert.Equal(t, failed, testutil.ToFloat64(metrics.DeploymentsFailed))
+}
+
+func TestSetConnectionState(t *testing.T) {
+	metrics.SetConnectionState("connected", []string{"connecting", "connected"})
+
+	assert.Equal(t, 1.0, testutil.ToFloat64(metrics.ConnectionState.WithLabelValues("connected")))
+	assert.Equal(t, 0.0, testutil.ToFloat64(metrics.ConnectionState.WithLabelValues("connecting")))
+}
@@ -0,0 +1,111 @@
+// Package tracing sets up the OpenTelemetry spans of the agents, the trace context arrives from crux
+// with the commands and is sent back in the gRPC metadata of the status streams.
+package tracing
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/rs/zerolog/log"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.12.0"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/dyrector-io/dyrectorio/golang/internal/config"
+	"github.com/dyrector-io/dyrectorio/golang/internal/version"
+)
+
+const tracerName = "github.com/dyrector-io/dyrectorio/golang"
+
+// span attribute keys shared by the agents
+const (
+	DeploymentIDKey = attribute.Key("dyo.deployment.id")
+	PrefixKey       = attribute.Key("dyo.prefix")
+	ContainerKey    = attribute.Key("dyo.container")
+	ImageKey        = attribute.Key("dyo.image")
+)
+
+// W3C trace context, the same format crux uses
+var propagator = propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{})
+
+// Init starts exporting the spans to the configured OTLP collector, without a collector the spans are no-ops.
+// The returned function flushes the pending spans.
+func Init(ctx context.Context, appConfig *config.CommonConfiguration) (shutdown func(context.Context) error, err error) {
+	otel.SetTextMapPropagator(propagator)
+
+	if appConfig.TracingEndpoint == "" {
+		return func(context.Context) error { return nil }, nil
+	}
+
+	options := []otlptracegrpc.Option{otlptracegrpc.WithEndpoint(appConfig.TracingEndpoint)}
+	if appConfig.TracingInsecure {
+		options = append(options, otlptracegrpc.WithInsecure())
+	}
+
+	exporter, err := otlptracegrpc.New(ctx, options...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create the trace exporter: %w", err)
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(resource.NewWithAttributes(semconv.SchemaURL,
+			semconv.ServiceNameKey.String(appConfig.Name),
+			semconv.ServiceVersionKey.String(version.BuildVersion()),
+		)),
+	)
+	otel.SetTracerProvider(provider)
+
+	log.Info().Str("endpoint", appConfig.TracingEndpoint).Msg("Exporting traces")
+	return provider.Shutdown, nil
+}
+
+// Start starts a span as the child of the span in the context, if any
+func Start(ctx context.Context, name string, attributes ...attribute.KeyValue) (context.Context, trace.Span) {
+	return otel.Tracer(tracerName).Start(ctx, name, trace.WithAttributes(attributes...))
+}
+
+// End ends the span, marking it failed if err is not nil
+func End(span trace.Span, err error) {
+	Fail(span, err)
+	span.End()
+}
+
+// Fail marks the span failed if err is not nil
+func Fail(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+}
+
+// Extract returns a context with the remote span found in the trace context sent by crux
+func Extract(ctx context.Context, traceContext map[string]string) context.Context {
+	if len(traceContext) == 0 {
+		return ctx
+	}
+	return propagator.Extract(ctx, propagation.MapCarrier(traceContext))
+}
+
+// AppendToOutgoingContext adds the trace context of the current span to the outgoing gRPC metadata
+func AppendToOutgoingContext(ctx context.Context) context.Context {
+	carrier := propagation.MapCarrier{}
+	propagator.Inject(ctx, carrier)
+
+	keyValues := []string{}
+	for _, key := range carrier.Keys() {
+		keyValues = append(keyValues, key, carrier.Get(key))
+	}
+	if len(keyValues) == 0 {
+		return ctx
+	}
+
+	return metadata.AppendToOutgoingContext(ctx, keyValues...)
+}
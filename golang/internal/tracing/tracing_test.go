@@ -0,0 +1,46 @@
+//go:build unit
+// +build unit
+
+package tracing_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/dyrector-io/dyrectorio/golang/internal/tracing"
+)
+
+const traceparent = "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"
+
+func TestExtract(t *testing.T) {
+	ctx := tracing.Extract(context.Background(), map[string]string{"traceparent": traceparent})
+
+	spanContext := trace.SpanContextFromContext(ctx)
+	assert.True(t, spanContext.IsRemote())
+	assert.Equal(t, "4bf92f3577b34da6a3ce929d0e0e4736", spanContext.TraceID().String())
+}
+
+func TestExtractWithoutTraceContext(t *testing.T) {
+	ctx := context.Background()
+	assert.Equal(t, ctx, tracing.Extract(ctx, nil))
+}
+
+func TestAppendToOutgoingContext(t *testing.T) {
+	ctx := tracing.Extract(context.Background(), map[string]string{"traceparent": traceparent})
+	ctx = metadata.AppendToOutgoingContext(ctx, "dyo-deployment-id", "deployment-1")
+	ctx = tracing.AppendToOutgoingContext(ctx)
+
+	md, ok := metadata.FromOutgoingContext(ctx)
+	assert.True(t, ok)
+	assert.Equal(t, []string{"deployment-1"}, md.Get("dyo-deployment-id"))
+	assert.Equal(t, []string{traceparent}, md.Get("traceparent"))
+}
+
+func TestAppendToOutgoingContextWithoutSpan(t *testing.T) {
+	ctx := context.Background()
+	assert.Equal(t, ctx, tracing.AppendToOutgoingContext(ctx))
+}
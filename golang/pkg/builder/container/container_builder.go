@@ -7,6 +7,7 @@
 	"errors"
 	"fmt"
 	"io"
+	"time"
 
 	"github.com/docker/distribution/reference"
 	"github.com/docker/docker/api/types"
@@ -17,6 +18,8 @@
 	"github.com/docker/docker/client"
 	"github.com/docker/go-connections/nat"
 
+	"github.com/dyrector-io/dyrectorio/golang/internal/metrics"
+	"github.com/dyrector-io/dyrectorio/golang/internal/tracing"
 	dockerHelper "github.com/dyrector-io/dyrectorio/golang/pkg/helper/docker"
 	imageHelper "github.com/dyrector-io/dyrectorio/golang/pkg/helper/image"
 )
@@ -47,11 +50,13 @@ type Builder interface {
 	WithLogWriter(logger io.StringWriter) Builder
 	WithoutConflict() Builder
 	WithForcePullImage() Builder
+	WithPullPolicy(policy PullPolicy) Builder
 	WithExtraHosts(hosts []string) Builder
 	WithPreCreateHooks(hooks ...LifecycleFunc) Builder
 	WithPostCreateHooks(hooks ...LifecycleFunc) Builder
 	WithPreStartHooks(hooks ...LifecycleFunc) Builder
 	WithPostStartHooks(hooks ...LifecycleFunc) Builder
+	PrepareImage() error
 	Create() (Builder, error)
 	GetContainerID() *string
 	GetNetworkID() *string
@@ -86,9 +91,12 @@ type DockerContainerBuilder struct {
 	shell           []string
 	tty             bool
 	user            *int64
-	forcePull       bool
+	pullPolicy      PullPolicy
+	imagePrepared   bool
 	logger          io.StringWriter
 	extraHosts      []string
+	healthCheck     *container.HealthConfig
+	resources       container.Resources
 	hooksPreCreate  []LifecycleFunc
 	hooksPostCreate []LifecycleFunc
 	hooksPreStart   []LifecycleFunc
@@ -254,7 +262,13 @@ func (dc *DockerContainerBuilder) WithLogWriter(logger io.StringWriter) *DockerC
 
 // Sets the builder to force pull the image before creating the container.
 func (dc *DockerContainerBuilder) WithForcePullImage() *DockerContainerBuilder {
-	dc.forcePull = true
+	dc.pullPolicy = AlwaysPullPolicy
+	return dc
+}
+
+// Sets when the image is pulled, a missing image is pulled by default.
+func (dc *DockerContainerBuilder) WithPullPolicy(policy PullPolicy) *DockerContainerBuilder {
+	dc.pullPolicy = policy
 	return dc
 }
 
@@ -265,6 +279,43 @@ func (dc *DockerContainerBuilder) WithExtraHosts(hosts []string) *DockerContaine
 	return dc
 }
 
+// Sets the HEALTHCHECK of the container, overriding the one defined in the image.
+func (dc *DockerContainerBuilder) WithHealthCheck(healthCheck *container.HealthConfig) *DockerContainerBuilder {
+	dc.healthCheck = healthCheck
+	return dc
+}
+
+// Sets the CPU limit of the container in units of 10^-9 CPUs, 0 means unlimited.
+func (dc *DockerContainerBuilder) WithNanoCPUs(nanoCPUs int64) *DockerContainerBuilder {
+	dc.resources.NanoCPUs = nanoCPUs
+	return dc
+}
+
+// Sets the memory limit of the container in bytes, 0 means unlimited.
+func (dc *DockerContainerBuilder) WithMemory(memory int64) *DockerContainerBuilder {
+	dc.resources.Memory = memory
+	return dc
+}
+
+// Sets the soft memory limit of the container in bytes, 0 means unlimited.
+func (dc *DockerContainerBuilder) WithMemoryReservation(memoryReservation int64) *DockerContainerBuilder {
+	dc.resources.MemoryReservation = memoryReservation
+	return dc
+}
+
+// Sets the total memory limit (memory + swap) of the container in bytes, -1 means unlimited swap.
+// Setting it to the same value as the memory limit disables swap.
+func (dc *DockerContainerBuilder) WithMemorySwap(memorySwap int64) *DockerContainerBuilder {
+	dc.resources.MemorySwap = memorySwap
+	return dc
+}
+
+// Sets the maximum number of processes in the container, nil or 0 means unlimited.
+func (dc *DockerContainerBuilder) WithPidsLimit(pidsLimit *int64) *DockerContainerBuilder {
+	dc.resources.PidsLimit = pidsLimit
+	return dc
+}
+
 // Sets an array of hooks which runs before the container is created. ContainerID is nil in these hooks.
 func (dc *DockerContainerBuilder) WithPreCreateHooks(hooks ...LifecycleFunc) *DockerContainerBuilder {
 	dc.hooksPreCreate = hooks
@@ -297,21 +348,43 @@ func (dc *DockerContainerBuilder) GetNetworkIDs() []string {
 	return dc.networkIDs
 }
 
-// Creates the container using the configuration given by 'With...' functions.
-func (dc *DockerContainerBuilder) Create() (*DockerContainerBuilder, error) {
+// Pulls the image if it is missing or the pull is forced. Create prepares the image
+// unless it was prepared before, so the image can be inspected before creating the container.
+func (dc *DockerContainerBuilder) PrepareImage() error {
 	expandedImageName, err := imageHelper.ExpandImageName(dc.imageWithTag)
 	if err != nil {
 		dc.logWrite(fmt.Sprintf("Failed to parse image with tag ('%s'): %s", dc.imageWithTag, err.Error()))
-		return dc, err
+		return err
 	}
 
-	if err = prepareImage(dc, expandedImageName); err != nil {
+	pullCtx, span := tracing.Start(dc.ctx, "pull image", tracing.ImageKey.String(expandedImageName))
+	err = prepareImage(pullCtx, dc, expandedImageName)
+	tracing.End(span, err)
+	if interruptErr := dc.interrupted("image pull"); interruptErr != nil {
+		return interruptErr
+	}
+	if err != nil {
 		dc.logWrite(fmt.Sprintf("Failed to prepare image: %s", err.Error()))
-		return dc, err
+		return err
+	}
+
+	dc.imagePrepared = true
+	return nil
+}
+
+// Creates the container using the configuration given by 'With...' functions.
+func (dc *DockerContainerBuilder) Create() (*DockerContainerBuilder, error) {
+	if !dc.imagePrepared {
+		if err := dc.PrepareImage(); err != nil {
+			return dc, err
+		}
 	}
 
 	if dc.withoutConflict {
-		err = dockerHelper.DeleteContainerByName(dc.ctx, dc.containerName)
+		err := dockerHelper.DeleteContainerByName(dc.ctx, dc.containerName)
+		if interruptErr := dc.interrupted("conflict resolution"); interruptErr != nil {
+			return dc, interruptErr
+		}
 		if err != nil {
 			dc.logWrite(fmt.Sprintf("Failed to resolve conflict during creating the container: %v", err))
 			return dc, err
@@ -325,6 +398,7 @@ func (dc *DockerContainerBuilder) Create() (*DockerContainerBuilder, error) {
 		PortBindings: portListNat,
 		AutoRemove:   dc.remove,
 		ExtraHosts:   dc.extraHosts,
+		Resources:    dc.resources,
 	}
 
 	containerConfig := &container.Config{
@@ -336,6 +410,7 @@ func (dc *DockerContainerBuilder) Create() (*DockerContainerBuilder, error) {
 		Entrypoint:   dc.entrypoint,
 		Cmd:          dc.cmd,
 		Shell:        dc.shell,
+		Healthcheck:  dc.healthCheck,
 	}
 
 	if dc.user != nil {
@@ -355,6 +430,9 @@ func (dc *DockerContainerBuilder) Create() (*DockerContainerBuilder, error) {
 		hostConfig.NetworkMode = nw
 	} else {
 		networkIDs := createNetworks(dc)
+		if interruptErr := dc.interrupted("network creation"); interruptErr != nil {
+			return dc, interruptErr
+		}
 		if networkIDs == nil {
 			return dc, errors.New("failed to create networks")
 		}
@@ -372,10 +450,21 @@ func (dc *DockerContainerBuilder) Create() (*DockerContainerBuilder, error) {
 	if hookError := execHooks(dc, dc.hooksPreCreate); hookError != nil {
 		dc.logWrite(fmt.Sprintln("Container pre-create hook error: ", hookError))
 	}
+	if interruptErr := dc.interrupted("pre-create hooks"); interruptErr != nil {
+		return dc, interruptErr
+	}
 
-	containerCreateResp, err := dc.client.ContainerCreate(dc.ctx, containerConfig, hostConfig, nil, nil, name)
+	createStarted := time.Now()
+	createCtx, span := tracing.Start(dc.ctx, "create container", tracing.ContainerKey.String(name))
+	containerCreateResp, err := dc.client.ContainerCreate(createCtx, containerConfig, hostConfig, nil, nil, name)
+	tracing.End(span, err)
 	if err != nil {
 		dc.logWrite(fmt.Sprintln("Container create failed: ", err))
+	} else {
+		metrics.ObserveDeployStep(metrics.StepCreate, createStarted)
+	}
+	if interruptErr := dc.interrupted("container creation"); interruptErr != nil {
+		return dc, interruptErr
 	}
 	containers, err := dc.client.ContainerList(dc.ctx, types.ContainerListOptions{
 		All:     true,
@@ -441,7 +530,14 @@ func (dc *DockerContainerBuilder) CreateAndWaitUntilExit() (*WaitResult, error)
 // Returns true if successful, false and an error if not.
 func (dc *DockerContainerBuilder) Start() error {
 	if hookError := execHooks(dc, dc.hooksPreStart); hookError != nil {
+		if interruptErr := dc.interrupted("pre-start hooks"); interruptErr != nil {
+			return interruptErr
+		}
 		dc.logWrite(fmt.Sprintln("Container pre-start hook error: ", hookError))
+		return hookError
+	}
+	if interruptErr := dc.interrupted("pre-start hooks"); interruptErr != nil {
+		return interruptErr
 	}
 
 	if dc.containerID == nil {
@@ -449,7 +545,17 @@ func (dc *DockerContainerBuilder) Start() error {
 		return errors.New("container does not exist")
 	}
 
-	err := dc.client.ContainerStart(dc.ctx, *dc.containerID, types.ContainerStartOptions{})
+	startStarted := time.Now()
+	startCtx, span := tracing.Start(dc.ctx, "start container", tracing.ContainerKey.String(dc.containerName))
+	err := dc.client.ContainerStart(startCtx, *dc.containerID, types.ContainerStartOptions{})
+	tracing.End(span, err)
+	if err != nil {
+		if interruptErr := dc.interrupted("container start"); interruptErr != nil {
+			return interruptErr
+		}
+	} else {
+		metrics.ObserveDeployStep(metrics.StepStart, startStarted)
+	}
 
 	if hookError := execHooks(dc, dc.hooksPostStart); hookError != nil {
 		dc.logWrite(fmt.Sprintln("Container post-start hook error: ", hookError))
@@ -464,14 +570,22 @@ func (dc *DockerContainerBuilder) Start() error {
 	return nil
 }
 
-func prepareImage(dc *DockerContainerBuilder, imageName string) error {
-	pullRequired, err := needToPullImage(dc.ctx, dc.logger, imageName)
-	if err != nil {
-		return err
+func prepareImage(ctx context.Context, dc *DockerContainerBuilder, imageName string) error {
+	pullRequired := dc.pullPolicy == AlwaysPullPolicy
+	if !pullRequired {
+		missing, err := needToPullImage(ctx, dc.logger, imageName)
+		if err != nil {
+			return err
+		}
+
+		if missing && dc.pullPolicy == NeverPullPolicy {
+			return fmt.Errorf("image is missing and the pull policy is %s: %s", NeverPullPolicy, imageName)
+		}
+		pullRequired = missing
 	}
 
-	if pullRequired || dc.forcePull {
-		err = imageHelper.Pull(dc.ctx, dc.logger, imageName, dc.registryAuth)
+	if pullRequired {
+		err := imageHelper.Pull(ctx, dc.logger, imageName, dc.registryAuth)
 		if err != nil && err.Error() != "EOF" {
 			return fmt.Errorf("image pull error: %s", err.Error())
 		}
@@ -607,6 +721,15 @@ func getPortSet(portList []PortBinding) nat.PortSet {
 	return portSet
 }
 
+// Returns an error naming the step if the context of the builder is done, nil otherwise.
+func (dc *DockerContainerBuilder) interrupted(step string) error {
+	if err := dc.ctx.Err(); err != nil {
+		dc.logWrite(fmt.Sprintf("Interrupted during %s", step))
+		return fmt.Errorf("%s interrupted: %w", step, err)
+	}
+	return nil
+}
+
 func (dc *DockerContainerBuilder) logWrite(message string) {
 	if dc.logger != nil {
 		_, err := dc.logger.WriteString(message)
@@ -185,3 +185,27 @@ type Exec struct {
 func (e Exec) Start() error {
 	return e.client.ContainerExecStart(e.ctx, e.ExecID, e.execStartCheck)
 }
+
+// Starts the exec process and attaches to its streams, the caller closes the connection.
+func (e Exec) Attach() (types.HijackedResponse, error) {
+	return e.client.ContainerExecAttach(e.ctx, e.ExecID, e.execStartCheck)
+}
+
+// Resizes the tty of the exec process.
+func (e Exec) Resize(height, width uint) error {
+	return e.client.ContainerExecResize(e.ctx, e.ExecID, types.ResizeOptions{Height: height, Width: width})
+}
+
+// Returns the exit code of the exited exec process.
+func (e Exec) ExitCode() (int, error) {
+	inspect, err := e.client.ContainerExecInspect(e.ctx, e.ExecID)
+	if err != nil {
+		return 0, err
+	}
+
+	if inspect.Running {
+		return 0, fmt.Errorf("exec process is still running: %s", e.ExecID)
+	}
+
+	return inspect.ExitCode, nil
+}
@@ -49,6 +49,17 @@ type PortRangeBinding struct {
 	OnFailureRestartPolicy            RestartPolicyName = "on-failure"
 )
 
+// PullPolicy defines when the image of a container is pulled, the values are the same as the Kubernetes ones.
+type PullPolicy string
+
+const (
+	// the image is pulled if it is missing
+	EmptyPullPolicy        PullPolicy = ""
+	AlwaysPullPolicy       PullPolicy = "Always"
+	IfNotPresentPullPolicy PullPolicy = "IfNotPresent"
+	NeverPullPolicy        PullPolicy = "Never"
+)
+
 // RestartPolicyUnmarshalInvalidError represents custom error regarding restart policy
 type RestartPolicyUnmarshalInvalidError struct{}
 
@@ -8,6 +8,9 @@
 	"k8s.io/apimachinery/pkg/api/resource"
 
 	"github.com/dyrector-io/dyrectorio/golang/internal/grpc"
+	"github.com/dyrector-io/dyrectorio/golang/internal/health"
+	"github.com/dyrector-io/dyrectorio/golang/internal/metrics"
+	"github.com/dyrector-io/dyrectorio/golang/internal/tracing"
 	"github.com/dyrector-io/dyrectorio/golang/pkg/crane/config"
 	"github.com/dyrector-io/dyrectorio/golang/pkg/crane/crux"
 	"github.com/dyrector-io/dyrectorio/golang/pkg/crane/k8s"
@@ -26,23 +29,66 @@ func preflightChecks(cfg *config.Configuration) {
 	}
 }
 
-func Serve(cfg *config.Configuration) {
+func Serve(cfg *config.Configuration) error {
 	preflightChecks(cfg)
 	log.Info().Msg("Starting dyrector.io crane service.")
 
 	// TODO(robot9706): Implement updater
 	log.Debug().Msg("No update was set up")
 
+	if cfg.HealthCheckAddress != "" {
+		healthServer := health.NewServer(&cfg.CommonConfiguration, map[string]health.CheckFunc{
+			health.ServiceKubernetes: k8s.NewClient(cfg).Ping,
+		})
+		go func() {
+			if err := health.Serve(context.Background(), cfg.HealthCheckAddress, healthServer); err != nil {
+				log.Error().Err(err).Msg("Health check server stopped")
+			}
+		}()
+	}
+
+	if cfg.MetricsAddress != "" {
+		go func() {
+			if err := metrics.Serve(context.Background(), cfg.MetricsAddress, cfg.ReadHeaderTimeout); err != nil {
+				log.Error().Err(err).Msg("Metrics server stopped")
+			}
+		}()
+	}
+
+	shutdownTracing, err := tracing.Init(context.Background(), &cfg.CommonConfiguration)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := shutdownTracing(context.Background()); err != nil {
+			log.Error().Err(err).Msg("Failed to flush the traces")
+		}
+	}()
+
 	grpcParams := grpc.TokenToConnectionParams(cfg.GrpcToken)
 	grpcContext := grpc.WithGRPCConfig(context.Background(), cfg)
-	grpc.Init(grpcContext, grpcParams, &cfg.CommonConfiguration, grpc.WorkerFunctions{
+	return grpc.Init(grpcContext, grpcParams, &cfg.CommonConfiguration, grpc.WorkerFunctions{
 		Deploy:           k8s.Deploy,
 		Watch:            crux.GetDeployments,
+		ContainerEvents:  crux.GetDeploymentEvents,
 		Delete:           k8s.Delete,
 		DeleteContainers: k8s.DeleteMultiple,
 		SecretList:       crux.GetSecretsList,
 		ContainerLog:     k8s.PodLog,
-		Close:            grpcClose,
+		ContainerExec:    k8s.PodExec,
+		ContainerStats:   k8s.PodStats,
+		// container file browser
+		ContainerFileList:     k8s.PodFileList,
+		ContainerFileDownload: k8s.PodFileDownload,
+		ContainerFileUpload:   k8s.PodFileUpload,
+		Close:                 grpcClose,
+		RuntimeInfo: func(context.Context) (*agent.AgentRuntimeInfo, error) {
+			kubernetesVersion, err := k8s.NewClient(cfg).GetServerVersion()
+			if err != nil {
+				return nil, err
+			}
+			return &agent.AgentRuntimeInfo{KubernetesVersion: &kubernetesVersion}, nil
+		},
 	})
 }
 
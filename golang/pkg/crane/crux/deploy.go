@@ -34,6 +34,12 @@ func GetDeployments(ctx context.Context, namespace string) []*common.ContainerSt
 	return mapper.MapKubeDeploymentListToCruxStateItems(list, svc)
 }
 
+func GetDeploymentEvents(ctx context.Context, namespace string) (<-chan struct{}, error) {
+	cfg := grpc.GetConfigFromContext(ctx).(*config.Configuration)
+
+	return k8s.SubscribeDeploymentChanges(ctx, cfg, namespace)
+}
+
 func GetSecretsList(ctx context.Context, prefix, name string) ([]string, error) {
 	cfg := grpc.GetConfigFromContext(ctx).(*config.Configuration)
 	secretHandler := k8s.NewSecret(ctx, k8s.NewClient(cfg))
@@ -1,6 +1,7 @@
 package k8s
 
 import (
+	"context"
 	"path/filepath"
 
 	"github.com/rs/zerolog/log"
@@ -111,3 +112,28 @@ func (c *Client) VerifyAPIResourceExists(targetGroup, targetKind string) bool {
 
 	return found
 }
+
+// Ping checks whether the Kubernetes API server is reachable and healthy
+func (c *Client) Ping(ctx context.Context) error {
+	clientSet, err := c.GetClientSet()
+	if err != nil {
+		return err
+	}
+
+	return clientSet.Discovery().RESTClient().Get().AbsPath("/healthz").Do(ctx).Error()
+}
+
+// GetServerVersion returns the git version of the Kubernetes API server, eg. v1.25.4
+func (c *Client) GetServerVersion() (string, error) {
+	clientSet, err := c.GetClientSet()
+	if err != nil {
+		return "", err
+	}
+
+	info, err := clientSet.Discovery().ServerVersion()
+	if err != nil {
+		return "", err
+	}
+
+	return info.GitVersion, nil
+}
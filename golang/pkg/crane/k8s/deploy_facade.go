@@ -3,6 +3,7 @@
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/rs/zerolog/log"
 
@@ -10,6 +11,8 @@
 	"github.com/dyrector-io/dyrectorio/golang/internal/dogger"
 	"github.com/dyrector-io/dyrectorio/golang/internal/grpc"
 	"github.com/dyrector-io/dyrectorio/golang/internal/mapper"
+	"github.com/dyrector-io/dyrectorio/golang/internal/metrics"
+	"github.com/dyrector-io/dyrectorio/golang/internal/tracing"
 	"github.com/dyrector-io/dyrectorio/golang/internal/util"
 	builder "github.com/dyrector-io/dyrectorio/golang/pkg/builder/container"
 	"github.com/dyrector-io/dyrectorio/golang/pkg/crane/config"
@@ -80,7 +83,9 @@ func (d *DeployFacade) CheckPreConditions() error {
 		return fmt.Errorf("instance config name must be provided with environments")
 	}
 
-	err = d.namespace.DeployNamespace(d.params.InstanceConfig.ContainerPreName)
+	err = d.traceStep("apply namespace", func(context.Context) error {
+		return d.namespace.DeployNamespace(d.params.InstanceConfig.ContainerPreName)
+	})
 	if err != nil && !errors.IsNotFound(err) {
 		return fmt.Errorf("pre-deployment failure: %w", err)
 	}
@@ -91,16 +96,20 @@ func (d *DeployFacade) CheckPreConditions() error {
 // TODO docs
 func (d *DeployFacade) PreDeploy() error {
 	if d.params.InstanceConfig.UseSharedEnvs {
-		if err := d.configmap.loadSharedConfig(d.namespace.name); err != nil {
+		if err := d.traceStep("load shared configmap", func(context.Context) error {
+			return d.configmap.loadSharedConfig(d.namespace.name)
+		}); err != nil {
 			return err
 		}
 	} else {
 		if d.params.InstanceConfig.SharedEnvironment != nil {
-			if err := d.configmap.deployConfigMapData(
-				d.namespace.name,
-				d.params.InstanceConfig.ContainerPreName+"-shared",
-				mapper.PipeSeparatedToStringMap(&d.params.InstanceConfig.SharedEnvironment),
-			); err != nil {
+			if err := d.traceStep("apply shared configmap", func(context.Context) error {
+				return d.configmap.deployConfigMapData(
+					d.namespace.name,
+					d.params.InstanceConfig.ContainerPreName+"-shared",
+					mapper.PipeSeparatedToStringMap(&d.params.InstanceConfig.SharedEnvironment),
+				)
+			}); err != nil {
 				log.Error().Err(err).Stack().Msg("Namespace global config map error")
 				return err
 			}
@@ -108,50 +117,60 @@ func (d *DeployFacade) PreDeploy() error {
 	}
 
 	if d.params.InstanceConfig.Environment != nil {
-		if err := d.configmap.deployConfigMapData(
-			d.namespace.name,
-			d.params.InstanceConfig.Name+"-common",
-			mapper.PipeSeparatedToStringMap(&d.params.InstanceConfig.Environment),
-		); err != nil {
+		if err := d.traceStep("apply common configmap", func(context.Context) error {
+			return d.configmap.deployConfigMapData(
+				d.namespace.name,
+				d.params.InstanceConfig.Name+"-common",
+				mapper.PipeSeparatedToStringMap(&d.params.InstanceConfig.Environment),
+			)
+		}); err != nil {
 			log.Error().Err(err).Stack().Msg("Common config map error")
 			return err
 		}
 	}
 
 	if d.params.ContainerConfig.Environment != nil {
-		if err := d.configmap.deployConfigMapData(
-			d.namespace.name,
-			d.params.ContainerConfig.Container,
-			mapper.PipeSeparatedToStringMap(&d.params.ContainerConfig.Environment),
-		); err != nil {
+		if err := d.traceStep("apply container configmap", func(context.Context) error {
+			return d.configmap.deployConfigMapData(
+				d.namespace.name,
+				d.params.ContainerConfig.Container,
+				mapper.PipeSeparatedToStringMap(&d.params.ContainerConfig.Environment),
+			)
+		}); err != nil {
 			log.Error().Err(err).Stack().Msg("Container config map error")
 			return err
 		}
 	}
 
-	if err := d.configmap.deployConfigMapRuntime(
-		d.params.ContainerConfig.RuntimeConfigType,
-		d.namespace.name,
-		d.params.ContainerConfig.Container,
-		d.params.RuntimeConfig); err != nil {
+	if err := d.traceStep("apply runtime configmap", func(context.Context) error {
+		return d.configmap.deployConfigMapRuntime(
+			d.params.ContainerConfig.RuntimeConfigType,
+			d.namespace.name,
+			d.params.ContainerConfig.Container,
+			d.params.RuntimeConfig)
+	}); err != nil {
 		log.Error().Err(err).Stack().Msg("Container configMap-runtime error")
 		return err
 	}
 
-	if err := d.pvc.DeployPVC(
-		d.namespace.name,
-		d.params.ContainerConfig.Container,
-		d.params.ContainerConfig.Mounts,
-		d.params.ContainerConfig.Volumes,
-	); err != nil {
+	if err := d.traceStep("apply persistent volume claims", func(context.Context) error {
+		return d.pvc.DeployPVC(
+			d.namespace.name,
+			d.params.ContainerConfig.Container,
+			d.params.ContainerConfig.Mounts,
+			d.params.ContainerConfig.Volumes,
+		)
+	}); err != nil {
 		log.Error().Err(err).Stack().Msg("PVC deployment failed")
 		return err
 	}
 
-	if err := d.secret.applySecrets(
-		d.namespace.name,
-		d.params.ContainerConfig.Container,
-		d.params.ContainerConfig.Secrets); err != nil {
+	if err := d.traceStep("apply secrets", func(context.Context) error {
+		return d.secret.applySecrets(
+			d.namespace.name,
+			d.params.ContainerConfig.Container,
+			d.params.ContainerConfig.Secrets)
+	}); err != nil {
 		return err
 	}
 
@@ -163,19 +182,21 @@ func (d *DeployFacade) Deploy() error {
 	if d.params.ContainerConfig.Ports != nil {
 		portList = append(portList, d.params.ContainerConfig.Ports...)
 	}
-	if err := d.service.DeployService(
-		&ServiceParams{
-			namespace:     d.params.InstanceConfig.ContainerPreName,
-			name:          d.params.ContainerConfig.Container,
-			selector:      d.params.ContainerConfig.Container,
-			portBindings:  portList,
-			portRanges:    d.params.ContainerConfig.PortRanges,
-			useLB:         d.params.ContainerConfig.UseLoadBalancer,
-			LBAnnotations: d.params.ContainerConfig.ExtraLBAnnotations,
-			annotations:   d.params.ContainerConfig.Annotations.Service,
-			labels:        d.params.ContainerConfig.Labels.Service,
-		},
-	); err != nil {
+	if err := d.traceStep("apply service", func(context.Context) error {
+		return d.service.DeployService(
+			&ServiceParams{
+				namespace:     d.params.InstanceConfig.ContainerPreName,
+				name:          d.params.ContainerConfig.Container,
+				selector:      d.params.ContainerConfig.Container,
+				portBindings:  portList,
+				portRanges:    d.params.ContainerConfig.PortRanges,
+				useLB:         d.params.ContainerConfig.UseLoadBalancer,
+				LBAnnotations: d.params.ContainerConfig.ExtraLBAnnotations,
+				annotations:   d.params.ContainerConfig.Annotations.Service,
+				labels:        d.params.ContainerConfig.Labels.Service,
+			},
+		)
+	}); err != nil {
 		log.Error().Err(err).Stack().Msg("Error with service")
 		return err
 	}
@@ -184,49 +205,55 @@ func (d *DeployFacade) Deploy() error {
 
 	if d.params.imagePullSecrets != nil {
 		imagePullSecretName = fmt.Sprintf("%s-reg", d.params.ContainerConfig.Container)
-		if err := d.secret.ApplyRegistryAuthSecret(d.ctx,
-			d.params.InstanceConfig.ContainerPreName,
-			imagePullSecretName,
-			d.params.imagePullSecrets,
-			d.appConfig); err != nil {
+		if err := d.traceStep("apply registry secret", func(ctx context.Context) error {
+			return d.secret.ApplyRegistryAuthSecret(ctx,
+				d.params.InstanceConfig.ContainerPreName,
+				imagePullSecretName,
+				d.params.imagePullSecrets,
+				d.appConfig)
+		}); err != nil {
 			return err
 		}
 	}
 
-	if err := d.deployment.DeployDeployment(&deploymentParams{
-		image:           d.params.Image,
-		namespace:       d.params.InstanceConfig.ContainerPreName,
-		containerConfig: &d.params.ContainerConfig,
-		configMapsEnv:   d.configmap.avail,
-		secrets:         d.secret.avail,
-		volumes:         d.pvc.avail,
-		portList:        portList,
-		command:         d.params.ContainerConfig.Command,
-		args:            d.params.ContainerConfig.Args,
-		issuer:          d.params.Issuer,
-		annotations:     d.params.ContainerConfig.Annotations.Deployment,
-		labels:          d.params.ContainerConfig.Labels.Deployment,
-		pullSecretName:  imagePullSecretName,
+	if err := d.traceStep("apply deployment", func(context.Context) error {
+		return d.deployment.DeployDeployment(&deploymentParams{
+			image:           d.params.Image,
+			namespace:       d.params.InstanceConfig.ContainerPreName,
+			containerConfig: &d.params.ContainerConfig,
+			configMapsEnv:   d.configmap.avail,
+			secrets:         d.secret.avail,
+			volumes:         d.pvc.avail,
+			portList:        portList,
+			command:         d.params.ContainerConfig.Command,
+			args:            d.params.ContainerConfig.Args,
+			issuer:          d.params.Issuer,
+			annotations:     d.params.ContainerConfig.Annotations.Deployment,
+			labels:          d.params.ContainerConfig.Labels.Deployment,
+			pullSecretName:  imagePullSecretName,
+		})
 	}); err != nil {
 		log.Error().Err(err).Stack().Msg("Error with deployment")
 		return err
 	}
 
 	if d.params.ContainerConfig.Expose {
-		if err := d.ingress.deployIngress(
-			&DeployIngressOptions{
-				namespace:     d.namespace.name,
-				containerName: d.params.ContainerConfig.Container,
-				ingressName:   d.params.ContainerConfig.IngressName,
-				ingressHost:   d.params.ContainerConfig.IngressHost,
-				uploadLimit:   d.params.ContainerConfig.IngressUploadLimit,
-				ports:         d.service.portsBound,
-				tls:           d.params.ContainerConfig.ExposeTLS,
-				proxyHeaders:  d.params.ContainerConfig.ProxyHeaders,
-				annotations:   d.params.ContainerConfig.Annotations.Ingress,
-				labels:        d.params.ContainerConfig.Labels.Ingress,
-			},
-		); err != nil {
+		if err := d.traceStep("apply ingress", func(context.Context) error {
+			return d.ingress.deployIngress(
+				&DeployIngressOptions{
+					namespace:     d.namespace.name,
+					containerName: d.params.ContainerConfig.Container,
+					ingressName:   d.params.ContainerConfig.IngressName,
+					ingressHost:   d.params.ContainerConfig.IngressHost,
+					uploadLimit:   d.params.ContainerConfig.IngressUploadLimit,
+					ports:         d.service.portsBound,
+					tls:           d.params.ContainerConfig.ExposeTLS,
+					proxyHeaders:  d.params.ContainerConfig.ProxyHeaders,
+					annotations:   d.params.ContainerConfig.Annotations.Ingress,
+					labels:        d.params.ContainerConfig.Labels.Ingress,
+				},
+			)
+		}); err != nil {
 			log.Error().Err(err).Stack().Msg("Error with ingress")
 		}
 	}
@@ -236,11 +263,13 @@ func (d *DeployFacade) Deploy() error {
 
 func (d *DeployFacade) PostDeploy() error {
 	if d.params.ContainerConfig.Metrics != nil && len(d.service.portNames) != 0 {
-		err := d.ServiceMonitor.Deploy(d.namespace.name,
-			d.params.ContainerConfig.Container,
-			*d.params.ContainerConfig.Metrics,
-			d.service.portNames[0],
-		)
+		err := d.traceStep("apply service monitor", func(context.Context) error {
+			return d.ServiceMonitor.Deploy(d.namespace.name,
+				d.params.ContainerConfig.Container,
+				*d.params.ContainerConfig.Metrics,
+				d.service.portNames[0],
+			)
+		})
 		if err != nil {
 			return err
 		}
@@ -251,6 +280,30 @@ func (d *DeployFacade) PostDeploy() error {
 	return nil
 }
 
+// traceStep runs an apply call in its own span, the clients of the facade use the context of the span meanwhile
+func (d *DeployFacade) traceStep(name string, step func(ctx context.Context) error) error {
+	ctx, span := tracing.Start(d.ctx, name)
+	d.useContext(ctx)
+	defer d.useContext(d.ctx)
+
+	err := step(ctx)
+	tracing.End(span, err)
+	return err
+}
+
+func (d *DeployFacade) useContext(ctx context.Context) {
+	d.namespace.ctx = ctx
+	d.deployment.ctx = ctx
+	d.configmap.ctx = ctx
+	d.service.ctx = ctx
+	d.ingress.ctx = ctx
+	d.secret.ctx = ctx
+	d.pvc.ctx = ctx
+	if d.ServiceMonitor != nil {
+		d.ServiceMonitor.Ctx = ctx
+	}
+}
+
 func (d *DeployFacade) Clear() error {
 	return nil
 }
@@ -286,20 +339,35 @@ func Deploy(c context.Context, dog *dogger.DeploymentLogger, deployImageRequest
 		cfg,
 	)
 
-	if err := deployFacade.CheckPreConditions(); err != nil {
-		return err
+	steps := []struct {
+		name string
+		run  func() error
+	}{
+		{name: "pre-condition check", run: deployFacade.CheckPreConditions},
+		{name: "pre-deploy", run: deployFacade.PreDeploy},
+		{name: "deploy", run: func() error {
+			applyStarted := time.Now()
+			if err := deployFacade.Deploy(); err != nil {
+				return err
+			}
+			metrics.ObserveDeployStep(metrics.StepK8sApply, applyStarted)
+			return nil
+		}},
+		{name: "post-deploy", run: deployFacade.PostDeploy},
 	}
 
-	if err := deployFacade.PreDeploy(); err != nil {
-		return err
-	}
+	for _, step := range steps {
+		if err := c.Err(); err != nil {
+			return fmt.Errorf("%s interrupted: %w", step.name, err)
+		}
 
-	if err := deployFacade.Deploy(); err != nil {
-		return err
+		if err := step.run(); err != nil {
+			if c.Err() != nil {
+				return fmt.Errorf("%s interrupted: %w", step.name, err)
+			}
+			return err
+		}
 	}
 
-	if err := deployFacade.PostDeploy(); err != nil {
-		return err
-	}
 	return nil
 }
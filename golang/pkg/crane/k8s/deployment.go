@@ -227,6 +227,10 @@ func buildContainer(p *deploymentParams,
 				WithRunAsUser(*p.containerConfig.User))
 	}
 
+	if p.containerConfig.PullPolicy != builder.EmptyPullPolicy {
+		container.WithImagePullPolicy(coreV1.PullPolicy(p.containerConfig.PullPolicy))
+	}
+
 	if p.containerConfig.Command != nil {
 		container.WithCommand(p.containerConfig.Command...)
 	}
@@ -13,3 +13,9 @@ func GetResourceManagementForTest(resourceConfig v1.ResourceConfig,
 ) (*corev1.ResourceRequirementsApplyConfiguration, error) {
 	return getResourceManagement(resourceConfig, cfg)
 }
+
+func BuildContainerForTest(image string, containerConfig *v1.ContainerConfig,
+	cfg *config.Configuration,
+) (*corev1.ContainerApplyConfiguration, error) {
+	return buildContainer(&deploymentParams{image: image, containerConfig: containerConfig}, cfg)
+}
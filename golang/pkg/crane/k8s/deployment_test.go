@@ -9,6 +9,7 @@
 	v1 "github.com/dyrector-io/dyrectorio/golang/api/v1"
 
 	"github.com/stretchr/testify/assert"
+	coreV1 "k8s.io/api/core/v1"
 
 	"github.com/dyrector-io/dyrectorio/golang/pkg/crane/config"
 	"github.com/dyrector-io/dyrectorio/golang/pkg/crane/k8s"
@@ -268,3 +269,24 @@ func TestResourceParsingFallbackRequestsMemoryError(t *testing.T) {
 
 	assert.ErrorIs(t, err, k8s.NewResourceError(k8s.FieldMemory, k8s.GroupRequests, true))
 }
+
+func TestBuildContainerPullPolicy(t *testing.T) {
+	cfg := &config.Configuration{
+		CommonConfiguration: internalconfig.CommonConfiguration{
+			DefaultLimitsCPU:     "1m",
+			DefaultLimitsMemory:  "2m",
+			DefaultRequestsCPU:   "3m",
+			DefaultRequestMemory: "4m",
+		},
+	}
+
+	container, err := k8s.BuildContainerForTest("nginx:latest", &v1.ContainerConfig{Container: "test"}, cfg)
+
+	assert.Nil(t, err)
+	assert.Nil(t, container.ImagePullPolicy)
+
+	container, err = k8s.BuildContainerForTest("nginx:latest", &v1.ContainerConfig{Container: "test", PullPolicy: "Never"}, cfg)
+
+	assert.Nil(t, err)
+	assert.Equal(t, coreV1.PullNever, *container.ImagePullPolicy)
+}
@@ -0,0 +1,137 @@
+package k8s
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/remotecommand"
+	utilexec "k8s.io/client-go/util/exec"
+
+	"github.com/dyrector-io/dyrectorio/golang/internal/grpc"
+	"github.com/dyrector-io/dyrectorio/golang/pkg/crane/config"
+	"github.com/dyrector-io/dyrectorio/protobuf/go/agent"
+	"github.com/dyrector-io/dyrectorio/protobuf/go/common"
+)
+
+// the shell used if the request has no command
+var defaultExecCommand = []string{"sh"}
+
+// terminalSizeQueue passes the resize events of the session to the executor
+type terminalSizeQueue struct {
+	resize <-chan grpc.TerminalSize
+}
+
+func (queue *terminalSizeQueue) Next() *remotecommand.TerminalSize {
+	size, ok := <-queue.resize
+	if !ok {
+		return nil
+	}
+
+	return &remotecommand.TerminalSize{Width: uint16(size.Width), Height: uint16(size.Height)}
+}
+
+// PodExec runs an interactive process in the first running pod of the deployment, the pod exec API
+// can not be canceled, the process ends when its stdin is closed with the session
+func PodExec(ctx context.Context, req *agent.ContainerExecRequest, session *grpc.ContainerExecSession) (int, error) {
+	command := req.GetCommand()
+	if len(command) == 0 {
+		command = defaultExecCommand
+	}
+
+	streamOptions := remotecommand.StreamOptions{
+		Stdin:             session.Stdin,
+		Stdout:            session.Stdout,
+		Tty:               req.GetTty(),
+		TerminalSizeQueue: &terminalSizeQueue{resize: session.Resize},
+	}
+	// the tty merges stderr into stdout
+	if !req.GetTty() {
+		streamOptions.Stderr = session.Stderr
+	}
+
+	err := podStream(ctx, req.GetContainer(), command, &streamOptions)
+
+	var exitErr utilexec.ExitError
+	if errors.As(err, &exitErr) {
+		return exitErr.ExitStatus(), nil
+	}
+	if err != nil {
+		return 0, fmt.Errorf("pod exec failed: %w", err)
+	}
+
+	return 0, nil
+}
+
+// podStream runs the command in the first running pod of the deployment, the streams
+// of the options are attached if they are set, a non-zero exit code is a utilexec.ExitError
+func podStream(ctx context.Context, container *common.ContainerIdentifier, command []string,
+	streamOptions *remotecommand.StreamOptions,
+) error {
+	cfg := grpc.GetConfigFromContext(ctx).(*config.Configuration)
+	namespace := container.GetPrefix()
+	name := container.GetName()
+
+	k8sClient := NewClient(cfg)
+	clientset, err := k8sClient.GetClientSet()
+	if err != nil {
+		return err
+	}
+
+	restConfig, err := k8sClient.GetRestConfig()
+	if err != nil {
+		return err
+	}
+	// the session lasts as long as the user needs it
+	restConfig.Timeout = 0
+
+	pod, err := getRunningPod(ctx, clientset, namespace, name)
+	if err != nil {
+		return err
+	}
+
+	execOptions := &v1.PodExecOptions{
+		Container: name,
+		Command:   command,
+		Stdin:     streamOptions.Stdin != nil,
+		Stdout:    streamOptions.Stdout != nil,
+		Stderr:    streamOptions.Stderr != nil,
+		TTY:       streamOptions.Tty,
+	}
+
+	execRequest := clientset.CoreV1().RESTClient().Post().
+		Resource("pods").
+		Namespace(namespace).
+		Name(pod.Name).
+		SubResource("exec").
+		VersionedParams(execOptions, scheme.ParameterCodec)
+
+	executor, err := remotecommand.NewSPDYExecutor(restConfig, http.MethodPost, execRequest.URL())
+	if err != nil {
+		return err
+	}
+
+	return executor.Stream(*streamOptions)
+}
+
+func getRunningPod(ctx context.Context, client *kubernetes.Clientset, namespace, name string) (*v1.Pod, error) {
+	pods, err := client.CoreV1().Pods(namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: fmt.Sprintf("app=%s", name),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range pods.Items {
+		if pods.Items[i].Status.Phase == v1.PodRunning {
+			return &pods.Items[i], nil
+		}
+	}
+
+	return nil, errors.New("no running pods found")
+}
@@ -0,0 +1,115 @@
+package k8s
+
+import (
+	"archive/tar"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+	"time"
+
+	"k8s.io/client-go/tools/remotecommand"
+	utilexec "k8s.io/client-go/util/exec"
+
+	"github.com/dyrector-io/dyrectorio/golang/internal/filelist"
+	"github.com/dyrector-io/dyrectorio/protobuf/go/agent"
+	"github.com/dyrector-io/dyrectorio/protobuf/go/common"
+)
+
+// the mode of the uploaded files if the request has none
+const defaultUploadMode = 0o644
+
+// PodFileList lists a directory of the first running pod of the deployment
+func PodFileList(ctx context.Context, req *agent.ContainerFileListRequest) ([]*agent.ContainerFileEntry, error) {
+	command, err := filelist.Command(req.GetPath())
+	if err != nil {
+		return nil, err
+	}
+
+	stdout := &bytes.Buffer{}
+	if err = podCommand(ctx, req.GetContainer(), command, nil, stdout); err != nil {
+		return nil, err
+	}
+
+	return filelist.Parse(stdout)
+}
+
+// PodFileDownload writes the file or directory of the pod as a tar archive, the same way as
+// the Docker API, the container needs tar for it
+func PodFileDownload(ctx context.Context, req *agent.ContainerFileDownloadRequest, archive io.Writer) error {
+	if !path.IsAbs(req.GetPath()) {
+		return fmt.Errorf("path is not absolute: %s", req.GetPath())
+	}
+
+	target := path.Clean(req.GetPath())
+	command := []string{"tar", "cf", "-", "-C", path.Dir(target), path.Base(target)}
+
+	return podCommand(ctx, req.GetContainer(), command, nil, archive)
+}
+
+// PodFileUpload writes the uploaded file into the pod, the container needs tar for it
+func PodFileUpload(ctx context.Context, req *agent.ContainerFileUploadRequest, content io.Reader) error {
+	if !path.IsAbs(req.GetPath()) {
+		return fmt.Errorf("path is not absolute: %s", req.GetPath())
+	}
+	if req.GetFileName() == "" || strings.Contains(req.GetFileName(), "/") {
+		return fmt.Errorf("invalid file name: %q", req.GetFileName())
+	}
+
+	// the content is checked against the size while it is received
+	archive := uploadArchive(req, content)
+	defer archive.Close()
+
+	command := []string{"tar", "xf", "-", "-C", req.GetPath()}
+	return podCommand(ctx, req.GetContainer(), command, archive, io.Discard)
+}
+
+// uploadArchive streams the file in a tar archive with its owner and mode, the content is not held in memory
+func uploadArchive(req *agent.ContainerFileUploadRequest, content io.Reader) io.ReadCloser {
+	mode := req.GetMode()
+	if mode == 0 {
+		mode = defaultUploadMode
+	}
+
+	header := &tar.Header{
+		Name:    req.GetFileName(),
+		Mode:    mode,
+		Size:    req.GetSize(),
+		Uid:     int(req.GetUid()),
+		Gid:     int(req.GetGid()),
+		ModTime: time.Now(),
+	}
+
+	reader, writer := io.Pipe()
+	go func() {
+		tarWriter := tar.NewWriter(writer)
+		err := tarWriter.WriteHeader(header)
+		if err == nil {
+			_, err = io.Copy(tarWriter, content)
+		}
+		if err == nil {
+			err = tarWriter.Close()
+		}
+		writer.CloseWithError(err)
+	}()
+
+	return reader
+}
+
+// podCommand runs a non-interactive command, the stderr is part of the error
+func podCommand(ctx context.Context, container *common.ContainerIdentifier, command []string,
+	stdin io.Reader, stdout io.Writer,
+) error {
+	stderr := &bytes.Buffer{}
+	err := podStream(ctx, container, command, &remotecommand.StreamOptions{Stdin: stdin, Stdout: stdout, Stderr: stderr})
+
+	var exitErr utilexec.ExitError
+	if errors.As(err, &exitErr) {
+		return fmt.Errorf("command exited with code %d: %s", exitErr.ExitStatus(), strings.TrimSpace(stderr.String()))
+	}
+
+	return err
+}
@@ -0,0 +1,11 @@
+package k8s
+
+import (
+	"io"
+
+	"github.com/dyrector-io/dyrectorio/protobuf/go/agent"
+)
+
+func UploadArchiveForTest(req *agent.ContainerFileUploadRequest, content io.Reader) io.Reader {
+	return uploadArchive(req, content)
+}
@@ -0,0 +1,53 @@
+//go:build unit
+// +build unit
+
+package k8s_test
+
+import (
+	"archive/tar"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dyrector-io/dyrectorio/golang/pkg/crane/k8s"
+	"github.com/dyrector-io/dyrectorio/protobuf/go/agent"
+)
+
+func TestUploadArchive(t *testing.T) {
+	req := &agent.ContainerFileUploadRequest{Path: "/app/config", FileName: "app.json", Uid: 1000, Gid: 2000, Mode: 0o600, Size: 15}
+
+	archive := k8s.UploadArchiveForTest(req, strings.NewReader(`{"debug": true}`))
+
+	tarReader := tar.NewReader(archive)
+	header, err := tarReader.Next()
+	assert.NoError(t, err)
+	assert.Equal(t, "app.json", header.Name)
+	assert.Equal(t, int64(0o600), header.Mode)
+	assert.Equal(t, 1000, header.Uid)
+	assert.Equal(t, 2000, header.Gid)
+
+	content, err := io.ReadAll(tarReader)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"debug": true}`, string(content))
+
+	_, err = tarReader.Next()
+	assert.ErrorIs(t, err, io.EOF)
+}
+
+func TestUploadArchiveDefaultMode(t *testing.T) {
+	archive := k8s.UploadArchiveForTest(&agent.ContainerFileUploadRequest{FileName: "empty"}, strings.NewReader(""))
+
+	header, err := tar.NewReader(archive).Next()
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0o644), header.Mode)
+	assert.Equal(t, int64(0), header.Size)
+}
+
+func TestUploadArchiveLargerThanSize(t *testing.T) {
+	archive := k8s.UploadArchiveForTest(&agent.ContainerFileUploadRequest{FileName: "app.json", Size: 2}, strings.NewReader("{}{}"))
+
+	_, err := io.ReadAll(archive)
+	assert.Error(t, err)
+}
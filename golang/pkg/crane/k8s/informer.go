@@ -0,0 +1,207 @@
+package k8s
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/rs/zerolog/log"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/dyrector-io/dyrectorio/golang/pkg/crane/config"
+)
+
+// deploymentChanges notifies the subscriber of a container state watch, the initial list of the informer is
+// not reported, the watch sends a snapshot after subscribing
+type deploymentChanges struct {
+	mutex   sync.Mutex
+	synced  bool
+	closed  bool
+	changes chan struct{}
+}
+
+func (subscriber *deploymentChanges) notify(interface{}) {
+	subscriber.mutex.Lock()
+	defer subscriber.mutex.Unlock()
+
+	if !subscriber.synced || subscriber.closed {
+		return
+	}
+
+	// a pending notification covers this change too
+	select {
+	case subscriber.changes <- struct{}{}:
+	default:
+	}
+}
+
+func (subscriber *deploymentChanges) setSynced() {
+	subscriber.mutex.Lock()
+	defer subscriber.mutex.Unlock()
+	subscriber.synced = true
+}
+
+func (subscriber *deploymentChanges) close() {
+	subscriber.mutex.Lock()
+	defer subscriber.mutex.Unlock()
+	subscriber.closed = true
+	close(subscriber.changes)
+}
+
+// namespaceInformer watches the Deployments and Pods of a namespace once for every subscriber,
+// it is stopped when the last one leaves
+type namespaceInformer struct {
+	factory     informers.SharedInformerFactory
+	stop        chan struct{}
+	refs        int
+	mutex       sync.Mutex
+	subscribers map[*deploymentChanges]struct{}
+}
+
+func (informer *namespaceInformer) notify(obj interface{}) {
+	informer.mutex.Lock()
+	defer informer.mutex.Unlock()
+
+	for subscriber := range informer.subscribers {
+		subscriber.notify(obj)
+	}
+}
+
+func (informer *namespaceInformer) subscribe(subscriber *deploymentChanges) {
+	informer.mutex.Lock()
+	defer informer.mutex.Unlock()
+	informer.subscribers[subscriber] = struct{}{}
+}
+
+func (informer *namespaceInformer) unsubscribe(subscriber *deploymentChanges) {
+	informer.mutex.Lock()
+	defer informer.mutex.Unlock()
+	delete(informer.subscribers, subscriber)
+}
+
+// informerRegistry keeps one reference counted informer for every watched namespace,
+// the empty namespace stands for all of them
+type informerRegistry struct {
+	mutex     sync.Mutex
+	informers map[string]*namespaceInformer
+	clientSet func() (kubernetes.Interface, error)
+}
+
+func (registry *informerRegistry) acquire(namespace string) (*namespaceInformer, error) {
+	registry.mutex.Lock()
+	defer registry.mutex.Unlock()
+
+	if informer, ok := registry.informers[namespace]; ok {
+		informer.refs++
+		return informer, nil
+	}
+
+	clientSet, err := registry.clientSet()
+	if err != nil {
+		return nil, err
+	}
+
+	options := []informers.SharedInformerOption{}
+	if namespace != "" {
+		options = append(options, informers.WithNamespace(namespace))
+	}
+
+	informer := &namespaceInformer{
+		factory:     informers.NewSharedInformerFactoryWithOptions(clientSet, 0, options...),
+		stop:        make(chan struct{}),
+		refs:        1,
+		subscribers: map[*deploymentChanges]struct{}{},
+	}
+
+	// client-go can not remove handlers, the informer has a single one for all of its subscribers
+	handler := cache.ResourceEventHandlerFuncs{
+		AddFunc:    informer.notify,
+		UpdateFunc: func(_, obj interface{}) { informer.notify(obj) },
+		DeleteFunc: informer.notify,
+	}
+	informer.factory.Apps().V1().Deployments().Informer().AddEventHandler(handler)
+	informer.factory.Core().V1().Pods().Informer().AddEventHandler(handler)
+	informer.factory.Start(informer.stop)
+
+	registry.informers[namespace] = informer
+	log.Info().Str("namespace", namespace).Msg("Deployment informer started")
+
+	return informer, nil
+}
+
+func (registry *informerRegistry) release(namespace string, informer *namespaceInformer) {
+	registry.mutex.Lock()
+	defer registry.mutex.Unlock()
+
+	informer.refs--
+	if informer.refs > 0 {
+		return
+	}
+
+	close(informer.stop)
+	delete(registry.informers, namespace)
+	log.Info().Str("namespace", namespace).Msg("Deployment informer stopped")
+}
+
+var (
+	deploymentInformersOnce sync.Once
+	deploymentInformers     *informerRegistry
+)
+
+func deploymentInformerRegistry(cfg *config.Configuration) *informerRegistry {
+	deploymentInformersOnce.Do(func() {
+		deploymentInformers = &informerRegistry{
+			informers: map[string]*namespaceInformer{},
+			clientSet: func() (kubernetes.Interface, error) {
+				restConfig, err := NewClient(cfg).GetRestConfig()
+				if err != nil {
+					return nil, err
+				}
+
+				// the default kube timeout would cut the long running watch requests
+				watchConfig := rest.CopyConfig(restConfig)
+				watchConfig.Timeout = 0
+
+				return kubernetes.NewForConfig(watchConfig)
+			},
+		}
+	})
+
+	return deploymentInformers
+}
+
+// SubscribeDeploymentChanges returns a channel notified when a Deployment or a Pod changes in the namespace,
+// or in any namespace if it is empty. The informer of the namespace is shared by the subscribers, it is synced
+// when this returns. The channel is closed when the context is done.
+func SubscribeDeploymentChanges(ctx context.Context, cfg *config.Configuration, namespace string) (<-chan struct{}, error) {
+	return subscribeDeploymentChanges(ctx, deploymentInformerRegistry(cfg), namespace)
+}
+
+func subscribeDeploymentChanges(ctx context.Context, registry *informerRegistry, namespace string) (<-chan struct{}, error) {
+	informer, err := registry.acquire(namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	subscriber := &deploymentChanges{changes: make(chan struct{}, 1)}
+	informer.subscribe(subscriber)
+
+	go func() {
+		<-ctx.Done()
+		informer.unsubscribe(subscriber)
+		subscriber.close()
+		registry.release(namespace, informer)
+	}()
+
+	for informerType, synced := range informer.factory.WaitForCacheSync(ctx.Done()) {
+		if !synced {
+			return nil, fmt.Errorf("failed to sync the informer of %v", informerType)
+		}
+	}
+	subscriber.setSynced()
+
+	return subscriber.changes, nil
+}
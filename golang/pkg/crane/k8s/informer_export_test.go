@@ -0,0 +1,28 @@
+package k8s
+
+import (
+	"context"
+
+	"k8s.io/client-go/kubernetes"
+)
+
+type InformerRegistryForTest struct {
+	registry *informerRegistry
+}
+
+func NewInformerRegistryForTest(clientSet func() (kubernetes.Interface, error)) *InformerRegistryForTest {
+	return &InformerRegistryForTest{registry: &informerRegistry{
+		informers: map[string]*namespaceInformer{},
+		clientSet: clientSet,
+	}}
+}
+
+func (r *InformerRegistryForTest) Subscribe(ctx context.Context, namespace string) (<-chan struct{}, error) {
+	return subscribeDeploymentChanges(ctx, r.registry, namespace)
+}
+
+func (r *InformerRegistryForTest) Informers() int {
+	r.registry.mutex.Lock()
+	defer r.registry.mutex.Unlock()
+	return len(r.registry.informers)
+}
@@ -0,0 +1,65 @@
+//go:build unit
+// +build unit
+
+package k8s_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/dyrector-io/dyrectorio/golang/pkg/crane/k8s"
+)
+
+func waitForChange(t *testing.T, changes <-chan struct{}) {
+	select {
+	case <-changes:
+	case <-time.After(5 * time.Second):
+		t.Fatal("change was not notified")
+	}
+}
+
+func TestSubscribeDeploymentChangesSharesTheInformerOfTheNamespace(t *testing.T) {
+	clientSet := fake.NewSimpleClientset()
+	created := 0
+	registry := k8s.NewInformerRegistryForTest(func() (kubernetes.Interface, error) {
+		created++
+		return clientSet, nil
+	})
+
+	firstCtx, firstCancel := context.WithCancel(context.Background())
+	defer firstCancel()
+	secondCtx, secondCancel := context.WithCancel(context.Background())
+	defer secondCancel()
+
+	first, err := registry.Subscribe(firstCtx, "prefix")
+	assert.NoError(t, err)
+	second, err := registry.Subscribe(secondCtx, "prefix")
+	assert.NoError(t, err)
+	assert.Equal(t, 1, created)
+
+	_, err = clientSet.AppsV1().Deployments("prefix").Create(context.Background(),
+		&appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "api", Namespace: "prefix"}}, metav1.CreateOptions{})
+	assert.NoError(t, err)
+	waitForChange(t, first)
+	waitForChange(t, second)
+
+	// the informer is kept while it has a subscriber
+	firstCancel()
+	for range first {
+		// drained until it is closed
+	}
+	assert.Equal(t, 1, registry.Informers())
+
+	secondCancel()
+	for range second {
+		// drained until it is closed
+	}
+	assert.Eventually(t, func() bool { return registry.Informers() == 0 }, time.Second, 10*time.Millisecond)
+}
@@ -0,0 +1,120 @@
+package k8s
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/rs/zerolog/log"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/dyrector-io/dyrectorio/golang/internal/grpc"
+	"github.com/dyrector-io/dyrectorio/golang/pkg/crane/config"
+	"github.com/dyrector-io/dyrectorio/protobuf/go/agent"
+	"github.com/dyrector-io/dyrectorio/protobuf/go/common"
+)
+
+// served by the metrics-server, if it is installed
+const metricsGroupVersion = "metrics.k8s.io/v1beta1"
+
+// the subset of the metrics.k8s.io PodMetricsList used by crane
+type podMetricsList struct {
+	Items []podMetrics `json:"items"`
+}
+
+type podMetrics struct {
+	metav1.ObjectMeta `json:"metadata"`
+	Containers        []containerMetrics `json:"containers"`
+}
+
+type containerMetrics struct {
+	Name  string          `json:"name"`
+	Usage v1.ResourceList `json:"usage"`
+}
+
+// PodStats samples the deployments of the request using the metrics API, the result is empty
+// if the metrics API is unavailable, the network and block I/O usage is not reported
+func PodStats(ctx context.Context, req *agent.ContainerStatsRequest) ([]*agent.ContainerStats, error) {
+	cfg := grpc.GetConfigFromContext(ctx).(*config.Configuration)
+
+	client, err := NewClient(cfg).GetClientSet()
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err = client.Discovery().ServerResourcesForGroupVersion(metricsGroupVersion); err != nil {
+		log.Debug().Err(err).Msg("Metrics API is unavailable, no container stats")
+		return []*agent.ContainerStats{}, nil
+	}
+
+	namespace := req.GetPrefix()
+	listOptions := metav1.ListOptions{}
+	if req.Name != nil {
+		listOptions.LabelSelector = fmt.Sprintf("app=%s", req.GetName())
+	}
+
+	pods, err := client.CoreV1().Pods(namespace).List(ctx, listOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	raw, err := client.CoreV1().RESTClient().Get().
+		AbsPath("/apis", metricsGroupVersion, "namespaces", namespace, "pods").
+		Param("labelSelector", listOptions.LabelSelector).
+		DoRaw(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not get pod metrics: %w", err)
+	}
+
+	metrics := &podMetricsList{}
+	if err = json.Unmarshal(raw, metrics); err != nil {
+		return nil, fmt.Errorf("could not decode pod metrics: %w", err)
+	}
+
+	return mapPodMetrics(namespace, pods.Items, metrics), nil
+}
+
+// mapPodMetrics sums the usage of the replicas by their app label
+func mapPodMetrics(namespace string, pods []v1.Pod, metrics *podMetricsList) []*agent.ContainerStats {
+	podsByName := map[string]*v1.Pod{}
+	for i := range pods {
+		podsByName[pods[i].Name] = &pods[i]
+	}
+
+	statsByApp := map[string]*agent.ContainerStats{}
+	for i := range metrics.Items {
+		pod, ok := podsByName[metrics.Items[i].Name]
+		if !ok || pod.Labels["app"] == "" {
+			continue
+		}
+
+		app := pod.Labels["app"]
+		stats, ok := statsByApp[app]
+		if !ok {
+			stats = &agent.ContainerStats{Container: &common.ContainerIdentifier{Prefix: namespace, Name: app}}
+			statsByApp[app] = stats
+		}
+
+		for _, container := range metrics.Items[i].Containers {
+			// 1000 millicores is one fully used core
+			stats.CpuPercent += float64(container.Usage.Cpu().MilliValue()) / 10
+			stats.MemoryUsage += uint64(container.Usage.Memory().Value())
+		}
+
+		for j := range pod.Spec.Containers {
+			stats.MemoryLimit += uint64(pod.Spec.Containers[j].Resources.Limits.Memory().Value())
+		}
+	}
+
+	result := make([]*agent.ContainerStats, 0, len(statsByApp))
+	for _, stats := range statsByApp {
+		result = append(result, stats)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Container.Name < result[j].Container.Name
+	})
+
+	return result
+}
@@ -0,0 +1,18 @@
+package k8s
+
+import (
+	"encoding/json"
+
+	v1 "k8s.io/api/core/v1"
+
+	"github.com/dyrector-io/dyrectorio/protobuf/go/agent"
+)
+
+func MapPodMetricsForTest(namespace string, pods []v1.Pod, metricsJSON []byte) ([]*agent.ContainerStats, error) {
+	metrics := &podMetricsList{}
+	if err := json.Unmarshal(metricsJSON, metrics); err != nil {
+		return nil, err
+	}
+
+	return mapPodMetrics(namespace, pods, metrics), nil
+}
@@ -0,0 +1,56 @@
+//go:build unit
+// +build unit
+
+package k8s_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/dyrector-io/dyrectorio/golang/pkg/crane/k8s"
+)
+
+func testPod(name, app, memoryLimit string) v1.Pod {
+	return v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Labels: map[string]string{"app": app}},
+		Spec: v1.PodSpec{Containers: []v1.Container{{
+			Name: app,
+			Resources: v1.ResourceRequirements{
+				Limits: v1.ResourceList{v1.ResourceMemory: resource.MustParse(memoryLimit)},
+			},
+		}}},
+	}
+}
+
+func TestMapPodMetricsSumsReplicas(t *testing.T) {
+	pods := []v1.Pod{testPod("api-1", "api", "256Mi"), testPod("api-2", "api", "256Mi"), testPod("web-1", "web", "128Mi")}
+	metrics := []byte(`{"items": [
+		{"metadata": {"name": "api-1"}, "containers": [{"name": "api", "usage": {"cpu": "250m", "memory": "64Mi"}}]},
+		{"metadata": {"name": "api-2"}, "containers": [{"name": "api", "usage": {"cpu": "1500m", "memory": "32Mi"}}]},
+		{"metadata": {"name": "web-1"}, "containers": [{"name": "web", "usage": {"cpu": "10m", "memory": "16Mi"}}]},
+		{"metadata": {"name": "unknown"}, "containers": [{"name": "x", "usage": {"cpu": "1", "memory": "1Gi"}}]}
+	]}`)
+
+	stats, err := k8s.MapPodMetricsForTest("prefix", pods, metrics)
+
+	assert.NoError(t, err)
+	assert.Len(t, stats, 2)
+	assert.Equal(t, "prefix", stats[0].Container.Prefix)
+	assert.Equal(t, "api", stats[0].Container.Name)
+	assert.InDelta(t, 175.0, stats[0].CpuPercent, 0.001)
+	assert.Equal(t, uint64(96*1024*1024), stats[0].MemoryUsage)
+	assert.Equal(t, uint64(512*1024*1024), stats[0].MemoryLimit)
+	assert.Equal(t, "web", stats[1].Container.Name)
+	assert.InDelta(t, 1.0, stats[1].CpuPercent, 0.001)
+}
+
+func TestMapPodMetricsEmpty(t *testing.T) {
+	stats, err := k8s.MapPodMetricsForTest("prefix", nil, []byte(`{"items": []}`))
+
+	assert.NoError(t, err)
+	assert.Empty(t, stats)
+}
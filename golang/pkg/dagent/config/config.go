@@ -1,6 +1,8 @@
 package config
 
 import (
+	"time"
+
 	"github.com/dyrector-io/dyrectorio/golang/internal/config"
 )
 
@@ -22,6 +24,34 @@ type Configuration struct {
 	TraefikPort     uint16 `yaml:"traefikPort"          env:"TRAEFIK_PORT"           env-default:"80"`
 	TraefikTLSPort  uint16 `yaml:"traefikTLSPort"       env:"TRAEFIK_TLS_PORT"       env-default:"443"`
 	WebhookToken    string `yaml:"webhookToken"         env:"WEBHOOK_TOKEN"          env-default:""`
+	// a new container has this long to become healthy, containers without a health check
+	// count as healthy after running for the startup grace period, the health check needs curl or wget in the image
+	ContainerHealthTimeout time.Duration `yaml:"containerHealthTimeout" env:"CONTAINER_HEALTH_TIMEOUT" env-default:"2m"`
+	ContainerStartupGrace  time.Duration `yaml:"containerStartupGrace"  env:"CONTAINER_STARTUP_GRACE"  env-default:"10s"`
+	// RWO and RWX volumes are Docker named volumes instead of host directories, the driver is "local" if empty,
+	// options are separated by semicolons as NFS options contain commas, eg. "type:nfs;o:addr=10.0.0.1,rw;device::/export"
+	NamedVolumes        bool              `yaml:"namedVolumes"         env:"NAMED_VOLUMES"          env-default:"false"`
+	VolumeDriver        string            `yaml:"volumeDriver"         env:"VOLUME_DRIVER"          env-default:""`
+	VolumeDriverOptions map[string]string `yaml:"volumeDriverOptions"  env:"VOLUME_DRIVER_OPTIONS"  env-default:"" env-separator:";"`
+	// limits of the containers without their own, "0" means unlimited, the common DEFAULT_LIMITS_* values
+	// are sized for Kubernetes and are not used by dagent
+	DockerDefaultLimitsCPU      string `yaml:"dockerDefaultLimitsCPU"      env:"DOCKER_DEFAULT_LIMITS_CPU"      env-default:"0"`
+	DockerDefaultLimitsMemory   string `yaml:"dockerDefaultLimitsMemory"   env:"DOCKER_DEFAULT_LIMITS_MEMORY"   env-default:"0"`
+	DockerDefaultRequestsMemory string `yaml:"dockerDefaultRequestsMemory" env:"DOCKER_DEFAULT_REQUESTS_MEMORY" env-default:"0"`
+	// maximum number of processes in a container, 0 means unlimited
+	DefaultLimitsPids int64 `yaml:"defaultLimitsPids"    env:"DEFAULT_LIMITS_PIDS"    env-default:"0"`
+	// image garbage collection keeps the most recently used tags of every repository and the images pulled or deployed
+	// within the min age, images of the containers are never removed, automatic pruning is disabled if the interval is 0
+	ImageRetentionTags   uint          `yaml:"imageRetentionTags"   env:"IMAGE_RETENTION_TAGS"    env-default:"3"`
+	ImageRetentionMinAge time.Duration `yaml:"imageRetentionMinAge" env:"IMAGE_RETENTION_MIN_AGE" env-default:"168h"`
+	ImagePruneInterval   time.Duration `yaml:"imagePruneInterval"   env:"IMAGE_PRUNE_INTERVAL"    env-default:"0"`
+	// containers are created from the repository digest of the pulled image (name:tag@sha256:...),
+	// a re-tagged image never changes what runs, images without a repository digest fail to deploy
+	PinImageDigest bool `yaml:"pinImageDigest"       env:"PIN_IMAGE_DIGEST"       env-default:"false"`
+	// secret files are written to the internal path, which is the host path mounted into dagent, the host path
+	// is bind-mounted into the containers, it should be on a tmpfs like /run of most distributions
+	SecretFilesHostPath     string `yaml:"secretFilesHostPath"     env:"SECRET_FILES_HOST_PATH"     env-default:"/run/dagent/secrets"`
+	SecretFilesInternalPath string `yaml:"secretFilesInternalPath" env:"SECRET_FILES_INTERNAL_PATH" env-default:"/run/dagent/secrets"`
 	// for injecting SecretPrivateKey,
 	SecretPrivateKeyFile KeyFromFile `yaml:"secretPrivateKeyFile" env:"SECRET_PRIVATE_KEY_FILE"  env-default:"/srv/dagent/private.key"`
 }
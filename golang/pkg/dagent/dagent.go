@@ -7,13 +7,16 @@
 	"github.com/rs/zerolog/log"
 
 	"github.com/dyrector-io/dyrectorio/golang/internal/grpc"
+	"github.com/dyrector-io/dyrectorio/golang/internal/health"
+	"github.com/dyrector-io/dyrectorio/golang/internal/metrics"
+	"github.com/dyrector-io/dyrectorio/golang/internal/tracing"
 	"github.com/dyrector-io/dyrectorio/golang/pkg/dagent/config"
 	"github.com/dyrector-io/dyrectorio/golang/pkg/dagent/update"
 	"github.com/dyrector-io/dyrectorio/golang/pkg/dagent/utils"
 	"github.com/dyrector-io/dyrectorio/protobuf/go/agent"
 )
 
-func Serve(cfg *config.Configuration) {
+func Serve(cfg *config.Configuration) error {
 	utils.PreflightChecks(cfg)
 	log.Info().Msg("Starting dyrector.io DAgent service")
 
@@ -33,11 +36,50 @@ func Serve(cfg *config.Configuration) {
 		}
 	}
 
+	if cfg.HealthCheckAddress != "" {
+		healthServer := health.NewServer(&cfg.CommonConfiguration, map[string]health.CheckFunc{
+			health.ServiceDocker: utils.DockerPing,
+		})
+		go func() {
+			if err := health.Serve(context.Background(), cfg.HealthCheckAddress, healthServer); err != nil {
+				log.Error().Err(err).Msg("Health check server stopped")
+			}
+		}()
+	}
+
+	if cfg.MetricsAddress != "" {
+		go func() {
+			if err := metrics.Serve(context.Background(), cfg.MetricsAddress, cfg.ReadHeaderTimeout); err != nil {
+				log.Error().Err(err).Msg("Metrics server stopped")
+			}
+		}()
+	}
+
+	periodicTasks := []grpc.PeriodicTask{}
+	if cfg.ImagePruneInterval > 0 {
+		periodicTasks = append(periodicTasks, grpc.PeriodicTask{
+			Name:     "imagePrune",
+			Interval: cfg.ImagePruneInterval,
+			Execute:  utils.PeriodicImagePrune,
+		})
+	}
+
+	shutdownTracing, err := tracing.Init(context.Background(), &cfg.CommonConfiguration)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := shutdownTracing(context.Background()); err != nil {
+			log.Error().Err(err).Msg("Failed to flush the traces")
+		}
+	}()
+
 	grpcParams := grpc.TokenToConnectionParams(cfg.GrpcToken)
 	grpcContext := grpc.WithGRPCConfig(context.Background(), cfg)
-	grpc.Init(grpcContext, grpcParams, &cfg.CommonConfiguration, grpc.WorkerFunctions{
+	return grpc.Init(grpcContext, grpcParams, &cfg.CommonConfiguration, grpc.WorkerFunctions{
 		Deploy:           utils.DeployImage,
 		Watch:            utils.GetContainersByPrefix,
+		ContainerEvents:  utils.ContainerEvents,
 		Delete:           utils.DeleteContainerByPrefixAndName,
 		SecretList:       utils.SecretList,
 		SelfUpdate:       update.SelfUpdate,
@@ -45,6 +87,20 @@ func Serve(cfg *config.Configuration) {
 		ContainerCommand: utils.ContainerCommand,
 		DeleteContainers: utils.DeleteContainers,
 		ContainerLog:     utils.ContainerLog,
+		VolumeBackup:     utils.BackupVolume,
+		VolumeRestore:    utils.RestoreVolume,
+		ContainerExec:    utils.ContainerExec,
+		ContainerStats:   utils.ContainerStats,
+		// container file browser
+		ContainerFileList:     utils.ContainerFileList,
+		ContainerFileDownload: utils.ContainerFileDownload,
+		ContainerFileUpload:   utils.ContainerFileUpload,
+		ImagePrune:            utils.ImagePrune,
+		DiskUsage:             utils.DiskUsage,
+		RuntimeInfo: func(ctx context.Context) (*agent.AgentRuntimeInfo, error) {
+			return utils.GetRuntimeInfo(ctx, cfg)
+		},
+		PeriodicTasks: periodicTasks,
 	})
 }
 
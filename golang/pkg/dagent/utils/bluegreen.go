@@ -0,0 +1,127 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/api/types/network"
+	"github.com/docker/docker/client"
+
+	v1 "github.com/dyrector-io/dyrectorio/golang/api/v1"
+	"github.com/dyrector-io/dyrectorio/golang/internal/dogger"
+	"github.com/dyrector-io/dyrectorio/golang/internal/grpc"
+	"github.com/dyrector-io/dyrectorio/golang/internal/tracing"
+	containerbuilder "github.com/dyrector-io/dyrectorio/golang/pkg/builder/container"
+	"github.com/dyrector-io/dyrectorio/golang/pkg/dagent/config"
+	dockerHelper "github.com/dyrector-io/dyrectorio/golang/pkg/helper/docker"
+)
+
+// the new container runs under the original name with this suffix until the switch
+const blueGreenSuffix = "-next"
+
+func isBlueGreen(containerConfig *v1.ContainerConfig) bool {
+	return strings.EqualFold(containerConfig.DeploymentStrategy, v1.DeploymentStrategyBlueGreen)
+}
+
+// blueGreenBlocker returns the reason why the two containers can not run side by side, empty if they can
+func blueGreenBlocker(containerConfig *v1.ContainerConfig, networkMode string, previous *types.Container) string {
+	if previous.State != "running" {
+		return "the previous container is not running"
+	}
+
+	if mode := container.NetworkMode(networkMode); mode.IsHost() || mode.IsContainer() || mode.IsNone() {
+		return fmt.Sprintf("network mode '%s' is not isolated", networkMode)
+	}
+
+	if len(containerConfig.PortRanges) > 0 {
+		return "port ranges are bound on the host"
+	}
+
+	for _, port := range containerConfig.Ports {
+		if port.PortBinding != nil {
+			return fmt.Sprintf("port %d is bound on the host", *port.PortBinding)
+		}
+	}
+
+	return ""
+}
+
+// deployBlueGreen starts the new container next to the previous one, switches the network aliases over
+// once it is healthy then removes the previous container. The previous one is left untouched on failure,
+// the deployment only counts as downgraded if the aliases were already pointing to the new container.
+func deployBlueGreen(ctx context.Context, dog *dogger.DeploymentLogger, cfg *config.Configuration,
+	builder *containerbuilder.DockerContainerBuilder, previous *types.Container, containerName string, aliases []string,
+) (err error) {
+	ctx, span := tracing.Start(ctx, "blue-green switch", tracing.ContainerKey.String(containerName))
+	defer func() { tracing.End(span, err) }()
+
+	cli, err := client.NewClientWithOpts(client.FromEnv, client.WithAPIVersionNegotiation())
+	if err != nil {
+		return err
+	}
+	defer cli.Close()
+
+	nextName := containerName + blueGreenSuffix
+	dog.Write("Starting the new container next to the running one: " + nextName)
+
+	builder.WithName(nextName).WithNetworkAliases(nextName)
+	if err = builder.CreateAndStart(); err != nil {
+		discardContainer(dog, nextName)
+		return fmt.Errorf("failed to start the new container: %w", err)
+	}
+	nextID := *builder.GetContainerID()
+
+	dog.Write("Waiting for the new container to become healthy")
+	if err = WaitForContainerHealthy(ctx, cli, nextID, cfg.ContainerHealthTimeout, cfg.ContainerStartupGrace, dog); err != nil {
+		discardContainer(dog, nextName)
+		return err
+	}
+
+	dog.Write("New container is healthy, switching over")
+	switchedNetworks, err := switchNetworkAliases(ctx, cli, nextID, builder.GetNetworkIDs(), aliases)
+	if err != nil {
+		discardContainer(dog, nextName)
+		err = fmt.Errorf("failed to switch the network aliases: %w", err)
+		if switchedNetworks > 0 {
+			return &grpc.DowngradedError{Err: err}
+		}
+		return err
+	}
+
+	if err = dockerHelper.DeleteContainerByID(ctx, dog, previous.ID); err != nil {
+		return fmt.Errorf("failed to remove the previous container: %w", err)
+	}
+
+	if err = cli.ContainerRename(ctx, nextID, containerName); err != nil {
+		return fmt.Errorf("failed to rename the new container: %w", err)
+	}
+
+	return nil
+}
+
+// switchNetworkAliases reconnects the container to the networks with the aliases of the previous container,
+// the aliases of a connected container can not be changed in place, returns the number of networks switched
+func switchNetworkAliases(ctx context.Context, cli client.NetworkAPIClient, containerID string, networkIDs, aliases []string) (int, error) {
+	for i, networkID := range networkIDs {
+		if err := cli.NetworkDisconnect(ctx, networkID, containerID, false); err != nil {
+			return i, fmt.Errorf("network %s: %w", networkID, err)
+		}
+
+		if err := cli.NetworkConnect(ctx, networkID, containerID, &network.EndpointSettings{Aliases: aliases}); err != nil {
+			return i, fmt.Errorf("network %s: %w", networkID, err)
+		}
+	}
+
+	return len(networkIDs), nil
+}
+
+// discardContainer removes the new container of a failed switch, errors are only logged
+func discardContainer(dog *dogger.DeploymentLogger, name string) {
+	// the deployment context might be canceled already, the cleanup has to run anyway
+	if err := dockerHelper.DeleteContainerByName(context.Background(), name); err != nil {
+		dog.Write(fmt.Sprintf("Failed to remove the new container (%s): %s", name, err.Error()))
+	}
+}
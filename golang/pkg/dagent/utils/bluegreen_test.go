@@ -0,0 +1,109 @@
+//go:build unit
+// +build unit
+
+package utils
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/AlekSi/pointer"
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/network"
+	"github.com/docker/docker/client"
+	"github.com/stretchr/testify/assert"
+
+	v1 "github.com/dyrector-io/dyrectorio/golang/api/v1"
+	"github.com/dyrector-io/dyrectorio/golang/pkg/builder/container"
+)
+
+func TestBlueGreenBlocker(t *testing.T) {
+	running := &types.Container{State: "running"}
+
+	assert.Empty(t, blueGreenBlocker(&v1.ContainerConfig{Ports: []container.PortBinding{{ExposedPort: 80}}}, "bridge", running))
+	assert.NotEmpty(t, blueGreenBlocker(&v1.ContainerConfig{}, "bridge", &types.Container{State: "exited"}))
+	assert.NotEmpty(t, blueGreenBlocker(&v1.ContainerConfig{}, "host", running))
+	assert.NotEmpty(t, blueGreenBlocker(&v1.ContainerConfig{
+		Ports: []container.PortBinding{{ExposedPort: 80, PortBinding: pointer.ToUint16(8080)}},
+	}, "bridge", running))
+	assert.NotEmpty(t, blueGreenBlocker(&v1.ContainerConfig{
+		PortRanges: []container.PortRangeBinding{{}},
+	}, "bridge", running))
+}
+
+// failingNetworkClient fails to connect the container to the given network
+type failingNetworkClient struct {
+	client.NetworkAPIClient
+	failingNetwork string
+}
+
+func (cli *failingNetworkClient) NetworkDisconnect(ctx context.Context, networkID, containerID string, force bool) error {
+	return nil
+}
+
+func (cli *failingNetworkClient) NetworkConnect(ctx context.Context, networkID, containerID string,
+	config *network.EndpointSettings,
+) error {
+	if networkID == cli.failingNetwork {
+		return errors.New("network is gone")
+	}
+	return nil
+}
+
+func TestSwitchNetworkAliasesCountsSwitchedNetworks(t *testing.T) {
+	switched, err := switchNetworkAliases(context.Background(), &failingNetworkClient{failingNetwork: "first"},
+		"id", []string{"first", "second"}, []string{"alias"})
+	assert.Error(t, err)
+	assert.Equal(t, 0, switched)
+
+	switched, err = switchNetworkAliases(context.Background(), &failingNetworkClient{failingNetwork: "second"},
+		"id", []string{"first", "second"}, []string{"alias"})
+	assert.Error(t, err)
+	assert.Equal(t, 1, switched)
+
+	switched, err = switchNetworkAliases(context.Background(), &failingNetworkClient{},
+		"id", []string{"first", "second"}, []string{"alias"})
+	assert.NoError(t, err)
+	assert.Equal(t, 2, switched)
+}
+
+func inspectWithState(state *types.ContainerState) *types.ContainerJSON {
+	return &types.ContainerJSON{ContainerJSONBase: &types.ContainerJSONBase{Name: "/test", State: state}}
+}
+
+func TestContainerHealthy(t *testing.T) {
+	now := time.Now()
+	startedAt := now.Add(-5 * time.Second).Format(time.RFC3339Nano)
+
+	healthy, err := containerHealthy(inspectWithState(&types.ContainerState{Running: true, StartedAt: startedAt}), time.Second, now)
+	assert.NoError(t, err)
+	assert.True(t, healthy)
+
+	healthy, err = containerHealthy(inspectWithState(&types.ContainerState{Running: true, StartedAt: startedAt}), time.Minute, now)
+	assert.NoError(t, err)
+	assert.False(t, healthy)
+
+	healthy, err = containerHealthy(inspectWithState(&types.ContainerState{
+		Running: true, StartedAt: startedAt, Health: &types.Health{Status: types.Starting},
+	}), time.Second, now)
+	assert.NoError(t, err)
+	assert.False(t, healthy)
+
+	healthy, err = containerHealthy(inspectWithState(&types.ContainerState{
+		Running: true, StartedAt: startedAt, Health: &types.Health{Status: types.Healthy},
+	}), time.Minute, now)
+	assert.NoError(t, err)
+	assert.True(t, healthy)
+}
+
+func TestContainerNotHealthy(t *testing.T) {
+	_, err := containerHealthy(inspectWithState(&types.ContainerState{Status: "exited", ExitCode: 1}), time.Second, time.Now())
+	assert.ErrorAs(t, err, new(*ContainerNotHealthyError))
+
+	_, err = containerHealthy(inspectWithState(&types.ContainerState{
+		Running: true, Health: &types.Health{Status: types.Unhealthy},
+	}), time.Second, time.Now())
+	assert.ErrorAs(t, err, new(*ContainerNotHealthyError))
+}
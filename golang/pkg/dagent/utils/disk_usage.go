@@ -0,0 +1,147 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/client"
+	"github.com/rs/zerolog/log"
+
+	"github.com/dyrector-io/dyrectorio/golang/internal/grpc"
+	"github.com/dyrector-io/dyrectorio/golang/pkg/dagent/config"
+	"github.com/dyrector-io/dyrectorio/protobuf/go/agent"
+)
+
+// DiskUsage reports the disk usage of the prefixes like docker system df
+func DiskUsage(ctx context.Context, req *agent.DiskUsageRequest) (*agent.DiskUsageResponse, error) {
+	cfg := grpc.GetConfigFromContext(ctx).(*config.Configuration)
+
+	cli, err := client.NewClientWithOpts(client.FromEnv, client.WithAPIVersionNegotiation())
+	if err != nil {
+		return nil, err
+	}
+
+	diskUsage, err := cli.DiskUsage(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not get disk usage: %w", err)
+	}
+
+	response := mapDiskUsage(&diskUsage, req.Prefix)
+	for _, prefixUsage := range response.Prefixes {
+		prefixUsage.MountsSize = directorySize(path.Join(cfg.InternalMountPath, prefixUsage.Prefix))
+	}
+
+	return response, nil
+}
+
+// mapDiskUsage groups the containers and named volumes by their prefix label
+func mapDiskUsage(diskUsage *types.DiskUsage, prefixFilter *string) *agent.DiskUsageResponse {
+	response := &agent.DiskUsageResponse{ImagesSize: nonNegative(diskUsage.LayersSize)}
+
+	imageSizes := map[string]int64{}
+	for _, image := range diskUsage.Images {
+		imageSizes[image.ID] = image.Size
+		if image.Containers == 0 {
+			response.ReclaimableImagesSize += nonNegative(image.Size - image.SharedSize)
+		}
+	}
+
+	for _, buildCache := range diskUsage.BuildCache {
+		response.BuildCacheSize += nonNegative(buildCache.Size)
+	}
+
+	prefixes := map[string]*agent.PrefixDiskUsage{}
+	prefixUsage := func(labels map[string]string) *agent.PrefixDiskUsage {
+		prefix, ok := labels[LabelDyrectorioOrg+LabelContainerPrefix]
+		if !ok || (prefixFilter != nil && prefix != *prefixFilter) {
+			return nil
+		}
+
+		usage, ok := prefixes[prefix]
+		if !ok {
+			usage = &agent.PrefixDiskUsage{Prefix: prefix}
+			prefixes[prefix] = usage
+		}
+		return usage
+	}
+
+	prefixImages := map[string]map[string]bool{}
+	for _, container := range diskUsage.Containers {
+		usage := prefixUsage(container.Labels)
+		if usage == nil {
+			continue
+		}
+
+		usage.Containers++
+		usage.ContainersSize += nonNegative(container.SizeRw)
+
+		if prefixImages[usage.Prefix] == nil {
+			prefixImages[usage.Prefix] = map[string]bool{}
+		}
+		if !prefixImages[usage.Prefix][container.ImageID] {
+			prefixImages[usage.Prefix][container.ImageID] = true
+			usage.ImagesSize += nonNegative(imageSizes[container.ImageID])
+		}
+	}
+
+	for _, volume := range diskUsage.Volumes {
+		usage := prefixUsage(volume.Labels)
+		if usage == nil || volume.UsageData == nil {
+			continue
+		}
+
+		// -1 if the driver can not tell
+		usage.VolumesSize += nonNegative(volume.UsageData.Size)
+	}
+
+	for _, usage := range prefixes {
+		response.Prefixes = append(response.Prefixes, usage)
+	}
+	sort.Slice(response.Prefixes, func(i, j int) bool {
+		return response.Prefixes[i].Prefix < response.Prefixes[j].Prefix
+	})
+
+	return response
+}
+
+// directorySize is the size of the files in the directory, zero if it does not exist
+func directorySize(dir string) uint64 {
+	var size uint64
+
+	err := filepath.WalkDir(dir, func(_ string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			if os.IsNotExist(err) {
+				return nil
+			}
+			return err
+		}
+
+		if entry.Type().IsRegular() {
+			info, err := entry.Info()
+			if err != nil {
+				return err
+			}
+			size += uint64(info.Size())
+		}
+		return nil
+	})
+	if err != nil {
+		log.Warn().Err(err).Str("path", dir).Msg("Failed to get the size of the directory")
+	}
+
+	return size
+}
+
+func nonNegative(size int64) uint64 {
+	if size < 0 {
+		return 0
+	}
+
+	return uint64(size)
+}
@@ -0,0 +1,52 @@
+//go:build unit
+// +build unit
+
+package utils
+
+import (
+	"testing"
+
+	"github.com/docker/docker/api/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMapDiskUsage(t *testing.T) {
+	prefixLabel := LabelDyrectorioOrg + LabelContainerPrefix
+	diskUsage := &types.DiskUsage{
+		LayersSize: 1000,
+		Images: []*types.ImageSummary{
+			{ID: "used", Size: 400, Containers: 2},
+			{ID: "unused", Size: 300, SharedSize: 100, Containers: 0},
+		},
+		Containers: []*types.Container{
+			{ImageID: "used", SizeRw: 10, Labels: map[string]string{prefixLabel: "a"}},
+			{ImageID: "used", SizeRw: 20, Labels: map[string]string{prefixLabel: "a"}},
+			{ImageID: "used", SizeRw: 5, Labels: map[string]string{prefixLabel: "b"}},
+			{ImageID: "used", SizeRw: 50},
+		},
+		Volumes: []*types.Volume{
+			{Labels: map[string]string{prefixLabel: "a"}, UsageData: &types.VolumeUsageData{Size: 70}},
+			{Labels: map[string]string{prefixLabel: "b"}, UsageData: &types.VolumeUsageData{Size: -1}},
+		},
+		BuildCache: []*types.BuildCache{{Size: 30}},
+	}
+
+	response := mapDiskUsage(diskUsage, nil)
+
+	assert.Equal(t, uint64(1000), response.ImagesSize)
+	assert.Equal(t, uint64(200), response.ReclaimableImagesSize)
+	assert.Equal(t, uint64(30), response.BuildCacheSize)
+	assert.Len(t, response.Prefixes, 2)
+	assert.Equal(t, "a", response.Prefixes[0].Prefix)
+	assert.Equal(t, uint32(2), response.Prefixes[0].Containers)
+	assert.Equal(t, uint64(30), response.Prefixes[0].ContainersSize)
+	assert.Equal(t, uint64(400), response.Prefixes[0].ImagesSize)
+	assert.Equal(t, uint64(70), response.Prefixes[0].VolumesSize)
+	assert.Equal(t, uint64(0), response.Prefixes[1].VolumesSize)
+
+	prefix := "b"
+	response = mapDiskUsage(diskUsage, &prefix)
+
+	assert.Len(t, response.Prefixes, 1)
+	assert.Equal(t, "b", response.Prefixes[0].Prefix)
+}
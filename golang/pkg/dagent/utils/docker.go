@@ -3,7 +3,6 @@
 import (
 	"archive/tar"
 	"bufio"
-	"bytes"
 	"context"
 	"encoding/binary"
 	"errors"
@@ -25,6 +24,7 @@
 	"github.com/dyrector-io/dyrectorio/golang/internal/dogger"
 	"github.com/dyrector-io/dyrectorio/golang/internal/grpc"
 	"github.com/dyrector-io/dyrectorio/golang/internal/mapper"
+	"github.com/dyrector-io/dyrectorio/golang/internal/tracing"
 	"github.com/dyrector-io/dyrectorio/golang/internal/util"
 	containerbuilder "github.com/dyrector-io/dyrectorio/golang/pkg/builder/container"
 	"github.com/dyrector-io/dyrectorio/golang/pkg/dagent/caps"
@@ -62,6 +62,29 @@ func GetServerInformation() (DockerVersion, error) {
 	return DockerVersion{ClientVersion: cli.ClientVersion(), ServerVersion: server.Version}, err
 }
 
+// GetRuntimeInfo returns the container runtime details reported to crux, eg. Docker or Podman and its version
+func GetRuntimeInfo(ctx context.Context, cfg *config.Configuration) (*agent.AgentRuntimeInfo, error) {
+	info := &agent.AgentRuntimeInfo{TraefikEnabled: &cfg.TraefikEnabled}
+
+	cli, err := client.NewClientWithOpts(client.FromEnv, client.WithAPIVersionNegotiation())
+	if err != nil {
+		return info, err
+	}
+	defer cli.Close()
+
+	server, err := cli.ServerVersion(ctx)
+	if err != nil {
+		return info, fmt.Errorf("could not get server version: %w", err)
+	}
+
+	if server.Platform.Name != "" {
+		info.ContainerRuntime = &server.Platform.Name
+	}
+	info.ContainerRuntimeVersion = &server.Version
+
+	return info, nil
+}
+
 func GetContainerLogs(name string, skip, take uint) []string {
 	ctx := context.Background()
 
@@ -165,29 +188,36 @@ func CopyToContainer(ctx context.Context, name string, meta v1.UploadFileData, f
 func WriteContainerFile(ctx context.Context, cli *client.Client,
 	container, filename string, meta v1.UploadFileData, fileSize int64, data io.Reader,
 ) error {
-	var buf bytes.Buffer
-	tarWriter := tar.NewWriter(&buf)
+	mode := meta.Mode
+	if mode == 0 {
+		mode = 0o644
+	}
 
 	tarHeader := &tar.Header{
 		Name:    filename,
-		Mode:    0o644,
+		Mode:    mode,
 		Size:    fileSize,
 		Uid:     meta.UID,
 		Gid:     meta.GID,
 		ModTime: time.Now(),
 	}
-	if err := tarWriter.WriteHeader(tarHeader); err != nil {
-		return err
-	}
-	if _, err := io.Copy(tarWriter, data); err != nil {
-		return err
-	}
-	if err := tarWriter.Close(); err != nil {
-		return err
-	}
+
+	// the archive is streamed, the content is not held in memory
+	reader, writer := io.Pipe()
+	go func() {
+		tarWriter := tar.NewWriter(writer)
+		err := tarWriter.WriteHeader(tarHeader)
+		if err == nil {
+			_, err = io.Copy(tarWriter, data)
+		}
+		if err == nil {
+			err = tarWriter.Close()
+		}
+		writer.CloseWithError(err)
+	}()
+	defer reader.Close()
 
 	log.Debug().Int64("bytes", tarHeader.Size).Str("path", filepath.Join(meta.FilePath, filename)).Msg("Writing file")
-	reader := bytes.NewReader(buf.Bytes())
 
 	err := cli.CopyToContainer(ctx, container, meta.FilePath, reader, types.CopyToContainerOptions{})
 	return err
@@ -210,6 +240,7 @@ func InspectContainer(name string) types.ContainerJSON {
 }
 
 func logDeployInfo(
+	ctx context.Context,
 	dog *dogger.DeploymentLogger,
 	deployImageRequest *v1.DeployImageRequest,
 	expandedImageName string,
@@ -225,7 +256,7 @@ func logDeployInfo(
 		fmt.Sprintln("Using image: ", expandedImageName),
 	)
 
-	labels, _ := GetImageLabels(expandedImageName)
+	labels, _ := GetImageLabels(ctx, expandedImageName)
 	if len(labels) > 0 {
 		labelsLog := []string{"Image labels:"}
 		for key, label := range labels {
@@ -242,19 +273,19 @@ func logDeployInfo(
 	if deployImageRequest.ContainerConfig.User != nil {
 		dog.Write(fmt.Sprintf("User: %v", *deployImageRequest.ContainerConfig.User))
 	}
-
-	if len(deployImageRequest.ContainerConfig.InitContainers) > 0 {
-		dog.Write("WARNING: missing implementation: initContainers!")
-	}
 }
 
 func buildMountList(cfg *config.Configuration, dog *dogger.DeploymentLogger, deployImageRequest *v1.DeployImageRequest) []mount.Mount {
 	mountList := mountStrToDocker(
-		// volumes are mapped into the legacy format, until further support of different types is needed
-		append(deployImageRequest.ContainerConfig.Mounts, volumesToMounts(deployImageRequest.ContainerConfig.Volumes)...),
+		deployImageRequest.ContainerConfig.Mounts,
 		deployImageRequest.InstanceConfig.ContainerPreName,
 		deployImageRequest.ContainerConfig.Container,
 		cfg)
+	mountList = append(mountList, volumesToDocker(
+		deployImageRequest.ContainerConfig.Volumes,
+		deployImageRequest.InstanceConfig.ContainerPreName,
+		deployImageRequest.ContainerConfig.Container,
+		cfg)...)
 	// dotnet specific magic
 	if containsConfig(mountList) {
 		var err error
@@ -277,7 +308,7 @@ func DeployImage(ctx context.Context,
 	dog *dogger.DeploymentLogger,
 	deployImageRequest *v1.DeployImageRequest,
 	versionData *v1.VersionData,
-) error {
+) (err error) {
 	containerName := getContainerName(deployImageRequest)
 	cfg := grpc.GetConfigFromContext(ctx).(*config.Configuration)
 
@@ -292,7 +323,7 @@ func DeployImage(ctx context.Context,
 
 	log.Debug().Str("name", imageName).Str("full", expandedImageName).Msg("Image name parsed")
 
-	logDeployInfo(dog, deployImageRequest, expandedImageName, containerName)
+	logDeployInfo(ctx, dog, deployImageRequest, expandedImageName, containerName)
 
 	envMap := MergeStringMapUnique(
 		mapper.PipeSeparatedToStringMap(&deployImageRequest.InstanceConfig.Environment),
@@ -302,42 +333,83 @@ func DeployImage(ctx context.Context,
 		return fmt.Errorf("deployment failed, secret error: %w", err)
 	}
 
-	envMap = MergeStringMapUnique(envMap, mapper.ByteMapToStringMap(secret))
+	envSecrets, fileSecrets, err := splitSecretFiles(secret, deployImageRequest.ContainerConfig.SecretFiles)
+	if err != nil {
+		return fmt.Errorf("deployment failed, secret error: %w", err)
+	}
+
+	envMap = MergeStringMapUnique(envMap, mapper.ByteMapToStringMap(envSecrets))
 	envList := EnvMapToSlice(envMap)
 	mountList := buildMountList(cfg, dog, deployImageRequest)
 
-	matchedContainer, err := dockerHelper.GetContainerByName(ctx, containerName)
+	resources, err := getDockerResources(&deployImageRequest.ContainerConfig.ResourceConfig, cfg)
 	if err != nil {
-		dog.WriteContainerState("", fmt.Sprintf("Failed to find container: %s", containerName))
-		return err
+		return fmt.Errorf("deployment failed, resource error: %w", err)
 	}
 
-	if matchedContainer != nil {
-		dog.WriteContainerState(matchedContainer.State)
+	builder := containerbuilder.NewDockerBuilder(ctx).
+		WithImage(expandedImageName).
+		WithRegistryAuth(deployImageRequest.RegistryAuth).
+		WithPullPolicy(deployImageRequest.ContainerConfig.PullPolicy).
+		WithLogWriter(dog)
+	if err = builder.PrepareImage(); err != nil {
+		return fmt.Errorf("deployment failed, image error: %w", err)
+	}
+	if err = recordImageUse(ctx, expandedImageName); err != nil {
+		log.Warn().Err(err).Str("image", expandedImageName).Msg("Failed to record the use of the image")
+	}
 
-		err = dockerHelper.DeleteContainerByID(ctx, dog, matchedContainer.ID)
-		if err != nil {
-			dog.WriteContainerState("", fmt.Sprintf("Failed to delete container (%s): %s", containerName, err.Error()))
-			return err
-		}
+	digestCtx, span := tracing.Start(ctx, "resolve image digest", tracing.ImageKey.String(expandedImageName))
+	image, imageDigest, err := resolveImageDigest(digestCtx, dog, expandedImageName, cfg)
+	tracing.End(span, err)
+	if err != nil {
+		return fmt.Errorf("deployment failed, image error: %w", err)
 	}
 
-	builder := containerbuilder.NewDockerBuilder(ctx)
 	networkMode, networks := setNetwork(deployImageRequest)
-	labels, err := setImageLabels(expandedImageName, deployImageRequest, cfg)
+	labelsCtx, span := tracing.Start(ctx, "inspect labels", tracing.ImageKey.String(expandedImageName))
+	labels, err := setImageLabels(labelsCtx, expandedImageName, deployImageRequest, cfg)
+	tracing.End(span, err)
 	if err != nil {
 		return fmt.Errorf("error building lables: %w", err)
 	}
+	if imageDigest != "" {
+		labels[LabelDyrectorioOrg+LabelImageDigest] = imageDigest
+	}
+
+	secretDir := ""
+	if len(fileSecrets) > 0 {
+		var secretMount mount.Mount
+		secretMount, secretDir, err = prepareSecretFiles(ctx, dog, cfg, deployImageRequest, image, fileSecrets)
+		if err != nil {
+			return fmt.Errorf("deployment failed, secret error: %w", err)
+		}
+		mountList = append(mountList, secretMount)
+
+		// the previous container is kept on failure, it needs its own secrets
+		defer func() {
+			if err != nil {
+				removeSecretDirectory(secretDir)
+			} else {
+				removeSecretFiles(cfg, deployImageRequest.InstanceConfig.ContainerPreName, deployImageRequest.ContainerConfig.Container, secretDir)
+			}
+		}()
+	}
 
-	builder.WithImage(expandedImageName).
+	aliases := []string{containerName, deployImageRequest.ContainerConfig.Container}
+	healthCheck, err := imageHealthCheck(ctx, dog, image,
+		&deployImageRequest.ContainerConfig.HealthCheckConfig, deployImageRequest.ContainerConfig.Ports)
+	if err != nil {
+		return fmt.Errorf("deployment failed, health check error: %w", err)
+	}
+	builder.WithImage(image).
 		WithName(containerName).
 		WithMountPoints(mountList).
 		WithPortBindings(deployImageRequest.ContainerConfig.Ports).
 		WithPortRanges(deployImageRequest.ContainerConfig.PortRanges).
 		WithNetworkMode(networkMode).
 		WithNetworks(networks).
-		WithNetworkAliases(containerName, deployImageRequest.ContainerConfig.Container).
-		WithRegistryAuth(deployImageRequest.RegistryAuth).
+		WithNetworkAliases(aliases...).
 		WithRestartPolicy(deployImageRequest.ContainerConfig.RestartPolicy).
 		WithEnv(envList).
 		WithLabels(labels).
@@ -345,18 +417,42 @@ func DeployImage(ctx context.Context,
 		WithUser(deployImageRequest.ContainerConfig.User).
 		WithEntrypoint(deployImageRequest.ContainerConfig.Command).
 		WithCmd(deployImageRequest.ContainerConfig.Args).
-		WithoutConflict().
-		WithLogWriter(dog)
+		WithHealthCheck(healthCheck).
+		WithoutConflict()
 
-	WithInitContainers(builder, &deployImageRequest.ContainerConfig, dog, cfg)
+	resources.apply(builder)
 
-	err = builder.CreateAndStart()
+	builder.WithPreStartHooks(
+		initContainerHooks(&deployImageRequest.ContainerConfig, deployImageRequest.InstanceConfig.ContainerPreName, envMap, dog, cfg)...,
+	)
+
+	previous, err := dockerHelper.GetContainerByName(ctx, containerName)
 	if err != nil {
-		dog.WriteContainerState("", fmt.Sprintf("Failed to start container (%s): %s", containerName, err.Error()))
+		dog.WriteContainerState("", fmt.Sprintf("Failed to find container: %s", containerName))
 		return err
 	}
 
-	matchedContainer, err = dockerHelper.GetContainerByID(ctx, *builder.GetContainerID())
+	switched := false
+	if previous != nil && isBlueGreen(&deployImageRequest.ContainerConfig) {
+		if blocker := blueGreenBlocker(&deployImageRequest.ContainerConfig, networkMode, previous); blocker != "" {
+			dog.Write("Blue/green deployment is not possible, recreating the container: " + blocker)
+		} else {
+			err = deployBlueGreen(ctx, dog, cfg, builder, previous, containerName, aliases)
+			if err != nil {
+				dog.WriteContainerState(previous.State, fmt.Sprintf("Failed to replace container (%s): %s", containerName, err.Error()))
+				return err
+			}
+			switched = true
+		}
+	}
+
+	if !switched {
+		if err = recreateContainer(ctx, dog, cfg, builder, previous, containerName, healthCheck != nil); err != nil {
+			return err
+		}
+	}
+
+	matchedContainer, err := dockerHelper.GetContainerByID(ctx, *builder.GetContainerID())
 	if err != nil || matchedContainer == nil {
 		dog.WriteContainerState("", fmt.Sprintf("Failed to find container (%s): %s", containerName, err.Error()))
 		return err
@@ -365,12 +461,107 @@ func DeployImage(ctx context.Context,
 	dog.WriteContainerState(matchedContainer.State, "Started container: "+containerName)
 
 	if versionData != nil {
-		DraftRelease(deployImageRequest.InstanceConfig.ContainerPreName, *versionData, v1.DeployVersionResponse{}, cfg)
+		DraftRelease(deployImageRequest.InstanceConfig.ContainerPreName, *versionData, v1.DeployVersionResponse{{
+			Started:   true,
+			ImageName: &deployImageRequest.ImageName,
+			Tag:       deployImageRequest.Tag,
+			Digest:    imageDigest,
+		}}, cfg)
 	}
 
 	return err
 }
 
+// resolveImageDigest returns the repository digest of the pulled image and the image the container is created from,
+// which is pinned to the digest if the configuration requires it
+func resolveImageDigest(ctx context.Context, dog *dogger.DeploymentLogger, expandedImageName string,
+	cfg *config.Configuration,
+) (image, imageDigest string, err error) {
+	imageDigest, err = imageHelper.RepoDigest(ctx, expandedImageName)
+	if err != nil {
+		if cfg.PinImageDigest {
+			return "", "", fmt.Errorf("could not resolve image digest: %w", err)
+		}
+
+		// eg. images built on the node
+		log.Warn().Err(err).Str("image", expandedImageName).Msg("Failed to resolve image digest")
+		return expandedImageName, "", nil
+	}
+
+	dog.Write("Image digest: " + imageDigest)
+	if !cfg.PinImageDigest {
+		return expandedImageName, imageDigest, nil
+	}
+
+	image, err = imageHelper.PinDigest(expandedImageName, imageDigest)
+	if err != nil {
+		return "", "", fmt.Errorf("could not pin image digest: %w", err)
+	}
+
+	dog.Write("Using pinned image: " + image)
+	return image, imageDigest, nil
+}
+
+// recreateContainer removes the previous container, if any, then creates and starts the new one.
+// The deployment waits for the new container to become healthy if it has a health check or there is
+// a previous container to fall back to, which is restored if the new one fails to start or to become healthy.
+func recreateContainer(ctx context.Context, dog *dogger.DeploymentLogger, cfg *config.Configuration,
+	builder *containerbuilder.DockerContainerBuilder, previous *types.Container, containerName string, healthGated bool,
+) error {
+	cli, err := client.NewClientWithOpts(client.FromEnv, client.WithAPIVersionNegotiation())
+	if err != nil {
+		return err
+	}
+	defer cli.Close()
+
+	var snapshot *containerSnapshot
+	if previous != nil {
+		snapshot, err = snapshotContainer(ctx, cli, previous.ID)
+		if err != nil {
+			dog.Write(fmt.Sprintf("Rollback is not possible: %s", err.Error()))
+		}
+
+		if err = removeContainer(ctx, dog, previous, containerName); err != nil {
+			return err
+		}
+	}
+
+	err = builder.CreateAndStart()
+	if err == nil && (snapshot != nil || healthGated) {
+		dog.Write("Waiting for the container to become healthy")
+		err = WaitForContainerHealthy(ctx, cli, *builder.GetContainerID(), cfg.ContainerHealthTimeout, cfg.ContainerStartupGrace, dog)
+	}
+	if err != nil {
+		dog.WriteContainerState("", fmt.Sprintf("Failed to start container (%s): %s", containerName, err.Error()))
+		if snapshot != nil {
+			return rollback(dog, cli, snapshot, builder.GetContainerID(), err)
+		}
+		return err
+	}
+
+	return nil
+}
+
+// removeContainer removes the container with the same name, it is recreated by the deployment
+func removeContainer(ctx context.Context, dog *dogger.DeploymentLogger, previous *types.Container, containerName string) (err error) {
+	ctx, span := tracing.Start(ctx, "remove container", tracing.ContainerKey.String(containerName))
+	defer func() { tracing.End(span, err) }()
+
+	dog.WriteContainerState(previous.State)
+
+	if err = ctx.Err(); err != nil {
+		return fmt.Errorf("container removal interrupted: %w", err)
+	}
+
+	err = dockerHelper.DeleteContainerByID(ctx, dog, previous.ID)
+	if err != nil {
+		dog.WriteContainerState("", fmt.Sprintf("Failed to delete container (%s): %s", containerName, err.Error()))
+		return err
+	}
+
+	return nil
+}
+
 func setNetwork(deployImageRequest *v1.DeployImageRequest) (networkMode string, networks []string) {
 	if deployImageRequest.ContainerConfig.Expose {
 		networkMode = "traefik"
@@ -380,17 +571,39 @@ func setNetwork(deployImageRequest *v1.DeployImageRequest) (networkMode string,
 	return networkMode, deployImageRequest.ContainerConfig.Networks
 }
 
-func WithInitContainers(dc *containerbuilder.DockerContainerBuilder, containerConfig *v1.ContainerConfig,
-	dog *dogger.DeploymentLogger, cfg *config.Configuration,
-) {
+// initContainerHooks run the config container, the import container then the init containers in order
+// before the container starts, the container is not started if any of them fails
+func initContainerHooks(containerConfig *v1.ContainerConfig, prefix string,
+	parentEnv map[string]string, dog *dogger.DeploymentLogger, cfg *config.Configuration,
+) []containerbuilder.LifecycleFunc {
 	initFuncs := []containerbuilder.LifecycleFunc{}
+	if containerConfig.ConfigContainer != nil {
+		loader := configLoader(containerConfig.ConfigContainer)
+		initFuncs = append(initFuncs,
+			func(ctx context.Context, client *client.Client,
+				containerName string, containerId *string,
+				mountList []mount.Mount, logger *io.StringWriter,
+			) error {
+				ctx, span := tracing.Start(ctx, "config container", tracing.ContainerKey.String(containerName))
+				mounts := initContainerMounts(loader.Volumes, containerConfig, prefix, cfg)
+				configError := runInitContainer(ctx, client, containerName, loader, mounts, []string{}, dog)
+				tracing.End(span, configError)
+				if configError != nil {
+					return fmt.Errorf("failed to load the config files: %w", configError)
+				}
+				return nil
+			})
+	}
 	if containerConfig.ImportContainer != nil {
 		initFuncs = append(initFuncs,
 			func(ctx context.Context, client *client.Client,
 				containerName string, containerId *string,
 				mountList []mount.Mount, logger *io.StringWriter,
 			) error {
-				if initError := spawnInitContainer(ctx, client, containerName, mountList, containerConfig.ImportContainer, dog, cfg); initError != nil {
+				ctx, span := tracing.Start(ctx, "import container", tracing.ContainerKey.String(containerName))
+				initError := spawnInitContainer(ctx, client, containerName, mountList, containerConfig.ImportContainer, dog, cfg)
+				tracing.End(span, initError)
+				if initError != nil {
 					dog.WriteDeploymentStatus(common.DeploymentStatus_FAILED, "Failed to spawn init container: "+initError.Error())
 					return initError
 				}
@@ -398,20 +611,23 @@ func(ctx context.Context, client *client.Client,
 				return nil
 			})
 	}
-	dc.WithPreStartHooks(initFuncs...)
-}
 
-func volumesToMounts(volumes []v1.Volume) []string {
-	mounts := []string{}
-	for i := range volumes {
-		mounts = append(mounts, volumeToMount(&volumes[i]))
+	for i := range containerConfig.InitContainers {
+		initContainer := &containerConfig.InitContainers[i]
+		initFuncs = append(initFuncs,
+			func(ctx context.Context, client *client.Client,
+				containerName string, containerId *string,
+				mountList []mount.Mount, logger *io.StringWriter,
+			) error {
+				ctx, span := tracing.Start(ctx, "init container", tracing.ContainerKey.String(initContainer.Name))
+				mounts := initContainerMounts(initContainer.Volumes, containerConfig, prefix, cfg)
+				envs := initContainerEnv(initContainer, parentEnv)
+				initError := runInitContainer(ctx, client, containerName, initContainer, mounts, envs, dog)
+				tracing.End(span, initError)
+				return initError
+			})
 	}
-	return mounts
-}
-
-func volumeToMount(vol *v1.Volume) string {
-	mountStr := fmt.Sprintf("%s|%s", vol.Name, vol.Path)
-	return mountStr
+	return initFuncs
 }
 
 func getContainerName(deployImageRequest *v1.DeployImageRequest) string {
@@ -486,6 +702,20 @@ func createRuntimeConfigFileOnHost(mounts []mount.Mount, containerName, containe
 		if err := os.WriteFile(path.Join(configDir, "appsettings.json"), []byte(runtimeConfig), os.ModePerm); err != nil {
 			return mounts, err
 		}
+
+		// the file is on the host, a named config volume would never see it
+		configVolume := util.JoinV("-", containerPreName, containerName, "config")
+		for i := range mounts {
+			if mounts[i].Type == mount.TypeVolume && mounts[i].Source == configVolume {
+				log.Info().Str("volume", configVolume).Msg("Runtime config is mounted from the host instead of the named volume")
+				mounts[i] = mount.Mount{
+					Type:     mount.TypeBind,
+					Source:   path.Join(cfg.DataMountPath, containerPreName, containerName, "config"),
+					Target:   mounts[i].Target,
+					ReadOnly: mounts[i].ReadOnly,
+				}
+			}
+		}
 	}
 
 	return mounts, nil
@@ -526,12 +756,12 @@ func MergeStringMapUnique(src, dest map[string]string) map[string]string {
 	return dest
 }
 
-func GetImageLabels(expandedImageName string) (map[string]string, error) {
-	ctx := context.Background()
+func GetImageLabels(ctx context.Context, expandedImageName string) (map[string]string, error) {
 	cli, err := client.NewClientWithOpts(client.FromEnv, client.WithAPIVersionNegotiation())
 	if err != nil {
 		panic(err)
 	}
+	defer cli.Close()
 
 	res, _, err := cli.ImageInspectWithRaw(ctx, expandedImageName)
 	if res.Config != nil && res.Config.Labels != nil {
@@ -540,12 +770,12 @@ func GetImageLabels(expandedImageName string) (map[string]string, error) {
 	return map[string]string{}, nil
 }
 
-func setImageLabels(expandedImageName string,
+func setImageLabels(ctx context.Context, expandedImageName string,
 	deployImageRequest *v1.DeployImageRequest,
 	cfg *config.Configuration,
 ) (map[string]string, error) {
 	// parse image labels
-	labels, err := GetImageLabels(expandedImageName)
+	labels, err := GetImageLabels(ctx, expandedImageName)
 	if err != nil {
 		return nil, fmt.Errorf("error get image labels: %w", err)
 	}
@@ -637,6 +867,7 @@ func ContainerCommand(ctx context.Context, command *common.ContainerCommandReque
 		err = cli.ContainerRestart(ctx, container.ID, nil)
 	} else {
 		log.Error().Str("operation", operation.String()).Str("prefix", prefix).Str("name", name).Msg("Unknown operation")
+		err = fmt.Errorf("unknown operation: %s", operation.String())
 	}
 
 	return err
@@ -648,8 +879,12 @@ func DeleteContainers(ctx context.Context, request *common.DeleteContainersReque
 		err = DeleteContainerByPrefixAndName(ctx, request.GetContainer().Prefix, request.GetContainer().Name)
 	} else if request.GetPrefix() != "" {
 		err = dockerHelper.DeleteContainersByLabel(ctx, getPrefixLabelFilter(request.GetPrefix()))
+		if err == nil {
+			removeSecretFiles(grpc.GetConfigFromContext(ctx).(*config.Configuration), request.GetPrefix(), "", "")
+		}
 	} else {
 		log.Error().Msg("Unknown DeleteContainers request")
+		err = errors.New("unknown DeleteContainers request")
 	}
 
 	return err
@@ -10,8 +10,10 @@
 	"github.com/docker/docker/api/types"
 	"github.com/rs/zerolog/log"
 
+	"github.com/dyrector-io/dyrectorio/golang/internal/grpc"
 	"github.com/dyrector-io/dyrectorio/golang/internal/mapper"
 	"github.com/dyrector-io/dyrectorio/golang/internal/util"
+	"github.com/dyrector-io/dyrectorio/golang/pkg/dagent/config"
 	dockerHelper "github.com/dyrector-io/dyrectorio/golang/pkg/helper/docker"
 	"github.com/dyrector-io/dyrectorio/protobuf/go/common"
 )
@@ -30,7 +32,15 @@ func GetContainersByPrefix(ctx context.Context, prefix string) []*common.Contain
 		log.Error().Stack().Err(err).Send()
 	}
 
-	return mapper.MapContainerState(containers, prefix)
+	stateItems := mapper.MapContainerState(containers, prefix)
+	for i := range containers {
+		// containers which are not pinned have the digest of their image as a label
+		if imageDigest, ok := GetOrganizationLabel(containers[i].Labels, LabelImageDigest); ok && stateItems[i].ImageDigest == nil {
+			stateItems[i].ImageDigest = &imageDigest
+		}
+	}
+
+	return stateItems
 }
 
 func GetContainerByPrefixAndName(ctx context.Context, prefix, name string) (*types.Container, error) {
@@ -65,7 +75,12 @@ func DeleteContainerByPrefixAndName(ctx context.Context, prefix, name string) er
 		return nil
 	}
 
-	return dockerHelper.DeleteContainer(ctx, container)
+	if err = dockerHelper.DeleteContainer(ctx, container); err != nil {
+		return err
+	}
+
+	removeSecretFiles(grpc.GetConfigFromContext(ctx).(*config.Configuration), prefix, name, "")
+	return nil
 }
 
 func getPrefixLabelFilter(prefix string) string {
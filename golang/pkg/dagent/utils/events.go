@@ -0,0 +1,61 @@
+package utils
+
+import (
+	"context"
+
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/filters"
+	"github.com/docker/docker/client"
+	"github.com/rs/zerolog/log"
+)
+
+// container events changing the state reported to crux, exec and attach events are left out
+var containerStateEvents = []string{
+	"create", "start", "restart", "stop", "die", "kill", "oom",
+	"pause", "unpause", "rename", "destroy", "health_status",
+}
+
+// ContainerEvents subscribes to the Docker events of the containers with the given prefix,
+// the returned channel is notified after every change and closed when the subscription ends
+func ContainerEvents(ctx context.Context, prefix string) (<-chan struct{}, error) {
+	cli, err := client.NewClientWithOpts(client.FromEnv, client.WithAPIVersionNegotiation())
+	if err != nil {
+		return nil, err
+	}
+
+	filter := filters.NewArgs(filters.Arg("type", "container"))
+	for _, event := range containerStateEvents {
+		filter.Add("event", event)
+	}
+	if prefix != "" {
+		filter.Add("label", getPrefixLabelFilter(prefix))
+	}
+
+	messages, errs := cli.Events(ctx, types.EventsOptions{Filters: filter})
+
+	changes := make(chan struct{}, 1)
+	go func() {
+		defer close(changes)
+		defer cli.Close()
+
+		for {
+			select {
+			case message := <-messages:
+				log.Trace().Str("prefix", prefix).Str("action", message.Action).Str("id", message.Actor.ID).Msg("Container event")
+
+				// a pending notification covers this change too
+				select {
+				case changes <- struct{}{}:
+				default:
+				}
+			case err := <-errs:
+				if ctx.Err() == nil {
+					log.Error().Err(err).Str("prefix", prefix).Msg("Container events error")
+				}
+				return
+			}
+		}
+	}()
+
+	return changes, nil
+}
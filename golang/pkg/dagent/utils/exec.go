@@ -0,0 +1,101 @@
+package utils
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/docker/docker/pkg/stdcopy"
+	"github.com/rs/zerolog/log"
+
+	"github.com/dyrector-io/dyrectorio/golang/internal/grpc"
+	containerbuilder "github.com/dyrector-io/dyrectorio/golang/pkg/builder/container"
+	"github.com/dyrector-io/dyrectorio/protobuf/go/agent"
+)
+
+// the shell used if the request has no command
+var defaultExecCommand = []string{"sh"}
+
+// ContainerExec runs an interactive process in the container until it exits or the session ends
+func ContainerExec(ctx context.Context, req *agent.ContainerExecRequest, session *grpc.ContainerExecSession) (int, error) {
+	prefix := req.GetContainer().GetPrefix()
+	name := req.GetContainer().GetName()
+
+	container, err := findContainer(ctx, req.GetContainer())
+	if err != nil {
+		return 0, err
+	}
+	if container.State != "running" {
+		return 0, fmt.Errorf("container is not running: %s", container.State)
+	}
+
+	command := req.GetCommand()
+	if len(command) == 0 {
+		command = defaultExecCommand
+	}
+
+	builder := containerbuilder.NewExecBuilder(ctx, &container.ID).
+		WithCmd(command).
+		WithAttachStdin().
+		WithAttachStdout().
+		WithAttachStderr()
+	if req.GetTty() {
+		builder = builder.WithTTY()
+	}
+
+	exec, err := builder.Create()
+	if err != nil {
+		return 0, fmt.Errorf("exec create failed: %w", err)
+	}
+
+	hijacked, err := exec.Attach()
+	if err != nil {
+		return 0, fmt.Errorf("exec attach failed: %w", err)
+	}
+	defer hijacked.Close()
+
+	finished := make(chan struct{})
+	defer close(finished)
+
+	go func() {
+		select {
+		case <-ctx.Done():
+			// unblocks the output copy
+			hijacked.Close()
+		case <-finished:
+		}
+	}()
+
+	go func() {
+		for size := range session.Resize {
+			if err := exec.Resize(size.Height, size.Width); err != nil {
+				log.Debug().Err(err).Str("prefix", prefix).Str("name", name).Msg("Failed to resize exec terminal")
+			}
+		}
+	}()
+
+	go func() {
+		if _, err := io.Copy(hijacked.Conn, session.Stdin); err != nil {
+			log.Debug().Err(err).Str("prefix", prefix).Str("name", name).Msg("Exec stdin closed")
+		}
+		if err := hijacked.CloseWrite(); err != nil {
+			log.Debug().Err(err).Str("prefix", prefix).Str("name", name).Msg("Failed to close exec stdin")
+		}
+	}()
+
+	// the output is not multiplexed with a tty
+	if req.GetTty() {
+		_, err = io.Copy(session.Stdout, hijacked.Reader)
+	} else {
+		_, err = stdcopy.StdCopy(session.Stdout, session.Stderr, hijacked.Reader)
+	}
+	if ctx.Err() != nil {
+		return 0, errors.New("exec session closed")
+	}
+	if err != nil {
+		return 0, fmt.Errorf("exec output failed: %w", err)
+	}
+
+	return exec.ExitCode()
+}
@@ -0,0 +1,150 @@
+package utils
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/client"
+	"github.com/docker/docker/pkg/stdcopy"
+
+	v1 "github.com/dyrector-io/dyrectorio/golang/api/v1"
+	"github.com/dyrector-io/dyrectorio/golang/internal/filelist"
+	containerbuilder "github.com/dyrector-io/dyrectorio/golang/pkg/builder/container"
+	"github.com/dyrector-io/dyrectorio/protobuf/go/agent"
+	"github.com/dyrector-io/dyrectorio/protobuf/go/common"
+)
+
+// ContainerFileList lists a directory of the running container
+func ContainerFileList(ctx context.Context, req *agent.ContainerFileListRequest) ([]*agent.ContainerFileEntry, error) {
+	command, err := filelist.Command(req.GetPath())
+	if err != nil {
+		return nil, err
+	}
+
+	container, err := findContainer(ctx, req.GetContainer())
+	if err != nil {
+		return nil, err
+	}
+
+	output, err := execOutput(ctx, container.ID, command)
+	if err != nil {
+		return nil, err
+	}
+
+	return filelist.Parse(bytes.NewReader(output))
+}
+
+// ContainerFileDownload writes the file or directory of the container as a tar archive
+func ContainerFileDownload(ctx context.Context, req *agent.ContainerFileDownloadRequest, archive io.Writer) error {
+	if !path.IsAbs(req.GetPath()) {
+		return fmt.Errorf("path is not absolute: %s", req.GetPath())
+	}
+
+	container, err := findContainer(ctx, req.GetContainer())
+	if err != nil {
+		return err
+	}
+
+	cli, err := client.NewClientWithOpts(client.FromEnv, client.WithAPIVersionNegotiation())
+	if err != nil {
+		return err
+	}
+
+	defer cli.Close()
+
+	content, _, err := cli.CopyFromContainer(ctx, container.ID, req.GetPath())
+	if err != nil {
+		return fmt.Errorf("could not copy from container: %w", err)
+	}
+	defer content.Close()
+
+	_, err = io.Copy(archive, content)
+	return err
+}
+
+// ContainerFileUpload writes the uploaded file into the container
+func ContainerFileUpload(ctx context.Context, req *agent.ContainerFileUploadRequest, content io.Reader) error {
+	if !path.IsAbs(req.GetPath()) {
+		return fmt.Errorf("path is not absolute: %s", req.GetPath())
+	}
+	if req.GetFileName() == "" || strings.Contains(req.GetFileName(), "/") {
+		return fmt.Errorf("invalid file name: %q", req.GetFileName())
+	}
+
+	container, err := findContainer(ctx, req.GetContainer())
+	if err != nil {
+		return err
+	}
+
+	cli, err := client.NewClientWithOpts(client.FromEnv, client.WithAPIVersionNegotiation())
+	if err != nil {
+		return err
+	}
+
+	defer cli.Close()
+
+	meta := v1.UploadFileData{
+		FilePath: req.GetPath(),
+		UID:      int(req.GetUid()),
+		GID:      int(req.GetGid()),
+		Mode:     req.GetMode(),
+	}
+
+	// the content is checked against the size while it is received
+	if err = WriteContainerFile(ctx, cli, container.ID, req.GetFileName(), meta, req.GetSize(), content); err != nil {
+		return fmt.Errorf("could not write the file: %w", err)
+	}
+
+	return nil
+}
+
+func findContainer(ctx context.Context, identifier *common.ContainerIdentifier) (*types.Container, error) {
+	container, err := GetContainerByPrefixAndName(ctx, identifier.GetPrefix(), identifier.GetName())
+	if err != nil {
+		return nil, err
+	}
+	if container == nil {
+		return nil, fmt.Errorf("container not found: %s", identifier.GetName())
+	}
+
+	return container, nil
+}
+
+// execOutput runs the command in the container and returns its stdout, a non-zero exit code is an error
+func execOutput(ctx context.Context, containerID string, command []string) ([]byte, error) {
+	exec, err := containerbuilder.NewExecBuilder(ctx, &containerID).
+		WithCmd(command).
+		WithAttachStdout().
+		WithAttachStderr().
+		Create()
+	if err != nil {
+		return nil, fmt.Errorf("exec create failed: %w", err)
+	}
+
+	hijacked, err := exec.Attach()
+	if err != nil {
+		return nil, fmt.Errorf("exec attach failed: %w", err)
+	}
+	defer hijacked.Close()
+
+	stdout := &bytes.Buffer{}
+	stderr := &bytes.Buffer{}
+	if _, err = stdcopy.StdCopy(stdout, stderr, hijacked.Reader); err != nil {
+		return nil, fmt.Errorf("exec output failed: %w", err)
+	}
+
+	exitCode, err := exec.ExitCode()
+	if err != nil {
+		return nil, err
+	}
+	if exitCode != 0 {
+		return nil, fmt.Errorf("exec exited with code %d: %s", exitCode, strings.TrimSpace(stderr.String()))
+	}
+
+	return stdout.Bytes(), nil
+}
@@ -0,0 +1,249 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/client"
+	"github.com/rs/zerolog/log"
+
+	v1 "github.com/dyrector-io/dyrectorio/golang/api/v1"
+	"github.com/dyrector-io/dyrectorio/golang/internal/dogger"
+	containerbuilder "github.com/dyrector-io/dyrectorio/golang/pkg/builder/container"
+)
+
+const containerHealthPollInterval = time.Second
+
+// the probe settings follow the defaults of crane
+const (
+	healthCheckInterval    = 10 * time.Second
+	healthCheckTimeout     = 5 * time.Second
+	healthCheckStartPeriod = 30 * time.Second
+	healthCheckRetries     = 3
+)
+
+// the HTTP clients looked for in the image, the health check runs them directly, the image might have no shell
+var healthCheckClients = []string{
+	"/usr/bin/curl", "/usr/local/bin/curl", "/bin/curl",
+	"/usr/bin/wget", "/usr/local/bin/wget", "/bin/wget",
+}
+
+// ContainerNotHealthyError is returned when a container exits or fails its health check
+type ContainerNotHealthyError struct {
+	Container string
+	Reason    string
+}
+
+func (err *ContainerNotHealthyError) Error() string {
+	return fmt.Sprintf("container %s is not healthy: %s", err.Container, err.Reason)
+}
+
+// imageHealthCheck maps the HTTP probes to a HEALTHCHECK of the image, nil if there is nothing to probe
+// or the image has neither curl nor wget, eg. distroless ones, they count as healthy after the startup grace
+func imageHealthCheck(ctx context.Context, dog *dogger.DeploymentLogger, image string,
+	healthCheckConfig *v1.HealthCheckConfig, ports []containerbuilder.PortBinding,
+) (*container.HealthConfig, error) {
+	url := healthCheckURL(healthCheckConfig, ports)
+	if url == "" {
+		return nil, nil
+	}
+
+	cli, err := client.NewClientWithOpts(client.FromEnv, client.WithAPIVersionNegotiation())
+	if err != nil {
+		return nil, err
+	}
+	defer cli.Close()
+
+	httpClient, err := findHealthCheckClient(ctx, cli, image)
+	if err != nil {
+		return nil, fmt.Errorf("failed to look for the health check client of the image: %w", err)
+	}
+	if httpClient == "" {
+		dog.Write("The image has neither curl nor wget, the health check is replaced by the startup grace period")
+		return nil, nil
+	}
+
+	return dockerHealthCheck(url, httpClient), nil
+}
+
+// healthCheckURL is the URL of the probe, Docker runs only one so the readiness probe is preferred,
+// the startup probe is covered by the start period. Empty if there is nothing to probe.
+func healthCheckURL(healthCheckConfig *v1.HealthCheckConfig, ports []containerbuilder.PortBinding) string {
+	probe := healthCheckConfig.ReadinessProbe
+	if probe == nil {
+		probe = healthCheckConfig.LivenessProbe
+	}
+	if probe == nil {
+		probe = healthCheckConfig.StartupProbe
+	}
+	if probe == nil {
+		return ""
+	}
+
+	port := healthCheckConfig.Port
+	if port == 0 && len(ports) > 0 {
+		port = ports[0].ExposedPort
+	}
+	if port == 0 {
+		return ""
+	}
+
+	return fmt.Sprintf("http://localhost:%d/%s", port, strings.TrimPrefix(probe.Path, "/"))
+}
+
+// dockerHealthCheck requests the URL with the HTTP client of the image, docker counts every
+// non-zero exit code as a failure
+func dockerHealthCheck(url, httpClient string) *container.HealthConfig {
+	test := []string{"CMD", httpClient, "-q", "-O", "/dev/null", url}
+	if path.Base(httpClient) == "curl" {
+		test = []string{"CMD", httpClient, "-fsS", "-o", "/dev/null", url}
+	}
+
+	return &container.HealthConfig{
+		Test:        test,
+		Interval:    healthCheckInterval,
+		Timeout:     healthCheckTimeout,
+		StartPeriod: healthCheckStartPeriod,
+		Retries:     healthCheckRetries,
+	}
+}
+
+// findHealthCheckClient returns the path of the HTTP client in the image, empty if there is none,
+// the files are looked up in a container which is created but never started
+func findHealthCheckClient(ctx context.Context, cli client.ContainerAPIClient, image string) (string, error) {
+	created, err := cli.ContainerCreate(ctx, &container.Config{
+		Image:           image,
+		Entrypoint:      []string{healthCheckClients[0]},
+		NetworkDisabled: true,
+	}, nil, nil, nil, "")
+	if err != nil {
+		return "", err
+	}
+
+	defer func() {
+		// the deployment context might be canceled already, the container has to be removed anyway
+		err := cli.ContainerRemove(context.Background(), created.ID, types.ContainerRemoveOptions{Force: true})
+		if err != nil {
+			log.Warn().Err(err).Str("image", image).Msg("Failed to remove the health check lookup container")
+		}
+	}()
+
+	for _, httpClient := range healthCheckClients {
+		_, err = cli.ContainerStatPath(ctx, created.ID, httpClient)
+		if err == nil {
+			return httpClient, nil
+		}
+		if !client.IsErrNotFound(err) {
+			return "", err
+		}
+	}
+
+	return "", nil
+}
+
+// WaitForContainerHealthy blocks until the container is healthy, containers without a health check
+// count as healthy once they have been running for the grace period. The output of the health checks
+// is written to the logger, if any.
+func WaitForContainerHealthy(ctx context.Context, cli client.APIClient, containerID string,
+	timeout, grace time.Duration, logger io.StringWriter,
+) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	ticker := time.NewTicker(containerHealthPollInterval)
+	defer ticker.Stop()
+
+	lastCheck := time.Time{}
+	for {
+		inspect, err := cli.ContainerInspect(ctx, containerID)
+		if err != nil {
+			return fmt.Errorf("failed to inspect container %s: %w", containerID, err)
+		}
+
+		if logger != nil && inspect.State != nil {
+			var messages []string
+			messages, lastCheck = healthLogSince(inspect.State.Health, lastCheck)
+			for _, message := range messages {
+				if _, err = logger.WriteString(message); err != nil {
+					log.Warn().Err(err).Msg("Failed to write health check log")
+				}
+			}
+		}
+
+		healthy, err := containerHealthy(&inspect, grace, time.Now())
+		if err != nil || healthy {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return &ContainerNotHealthyError{Container: inspect.Name, Reason: fmt.Sprintf("not healthy after %s", timeout)}
+		case <-ticker.C:
+		}
+	}
+}
+
+// containerHealthy returns true if the container is healthy, an error if it never will be
+func containerHealthy(inspect *types.ContainerJSON, grace time.Duration, now time.Time) (bool, error) {
+	state := inspect.State
+	if state == nil {
+		return false, nil
+	}
+
+	if !state.Running {
+		if state.Status == "created" {
+			return false, nil
+		}
+		return false, &ContainerNotHealthyError{
+			Container: inspect.Name,
+			Reason:    fmt.Sprintf("%s with exit code %d", state.Status, state.ExitCode),
+		}
+	}
+
+	if state.Health != nil {
+		switch state.Health.Status {
+		case types.Healthy:
+			return true, nil
+		case types.Unhealthy:
+			return false, &ContainerNotHealthyError{Container: inspect.Name, Reason: "health check failed"}
+		default:
+			return false, nil
+		}
+	}
+
+	startedAt, err := time.Parse(time.RFC3339Nano, state.StartedAt)
+	if err != nil {
+		return false, fmt.Errorf("invalid start time of container %s: %w", inspect.Name, err)
+	}
+
+	return now.Sub(startedAt) >= grace, nil
+}
+
+// healthLogSince formats the results of the health checks finished after the given time,
+// returns the end of the last one
+func healthLogSince(health *types.Health, since time.Time) ([]string, time.Time) {
+	messages := []string{}
+	if health == nil {
+		return messages, since
+	}
+
+	last := since
+	for _, result := range health.Log {
+		if result == nil || !result.End.After(since) {
+			continue
+		}
+
+		messages = append(messages, fmt.Sprintf("Health check exited with %d: %s", result.ExitCode, strings.TrimSpace(result.Output)))
+		if result.End.After(last) {
+			last = result.End
+		}
+	}
+
+	return messages, last
+}
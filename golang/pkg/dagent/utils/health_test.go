@@ -0,0 +1,114 @@
+//go:build unit
+// +build unit
+
+package utils
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/docker/docker/api/types"
+	dockerContainer "github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/api/types/network"
+	"github.com/docker/docker/client"
+	"github.com/docker/docker/errdefs"
+	specs "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/stretchr/testify/assert"
+
+	v1 "github.com/dyrector-io/dyrectorio/golang/api/v1"
+	"github.com/dyrector-io/dyrectorio/golang/pkg/builder/container"
+)
+
+func TestHealthCheckURLPrefersTheReadinessProbe(t *testing.T) {
+	url := healthCheckURL(&v1.HealthCheckConfig{
+		Port:           8080,
+		LivenessProbe:  &v1.Probe{Path: "/live"},
+		ReadinessProbe: &v1.Probe{Path: "/ready"},
+	}, nil)
+
+	assert.Equal(t, "http://localhost:8080/ready", url)
+}
+
+func TestHealthCheckURLFallsBackToTheExposedPort(t *testing.T) {
+	url := healthCheckURL(&v1.HealthCheckConfig{
+		StartupProbe: &v1.Probe{Path: "health"},
+	}, []container.PortBinding{{ExposedPort: 3000}})
+
+	assert.Equal(t, "http://localhost:3000/health", url)
+}
+
+func TestHealthCheckURLWithoutProbeOrPort(t *testing.T) {
+	assert.Empty(t, healthCheckURL(&v1.HealthCheckConfig{Port: 8080}, nil))
+	assert.Empty(t, healthCheckURL(&v1.HealthCheckConfig{LivenessProbe: &v1.Probe{Path: "/live"}}, nil))
+}
+
+func TestDockerHealthCheckRunsTheClientWithoutShell(t *testing.T) {
+	healthCheck := dockerHealthCheck("http://localhost:8080/ready", "/usr/bin/curl")
+
+	assert.Equal(t, []string{"CMD", "/usr/bin/curl", "-fsS", "-o", "/dev/null", "http://localhost:8080/ready"}, healthCheck.Test)
+	assert.Equal(t, healthCheckStartPeriod, healthCheck.StartPeriod)
+	assert.Equal(t, healthCheckRetries, healthCheck.Retries)
+
+	healthCheck = dockerHealthCheck("http://localhost:8080/ready", "/bin/wget")
+	assert.Equal(t, []string{"CMD", "/bin/wget", "-q", "-O", "/dev/null", "http://localhost:8080/ready"}, healthCheck.Test)
+}
+
+// imageFilesClient serves the files of an image from a created container
+type imageFilesClient struct {
+	client.ContainerAPIClient
+	files   []string
+	removed bool
+}
+
+func (cli *imageFilesClient) ContainerCreate(ctx context.Context, config *dockerContainer.Config,
+	hostConfig *dockerContainer.HostConfig, networkingConfig *network.NetworkingConfig, platform *specs.Platform, name string,
+) (dockerContainer.ContainerCreateCreatedBody, error) {
+	return dockerContainer.ContainerCreateCreatedBody{ID: "lookup"}, nil
+}
+
+func (cli *imageFilesClient) ContainerStatPath(ctx context.Context, containerID, path string) (types.ContainerPathStat, error) {
+	for _, file := range cli.files {
+		if file == path {
+			return types.ContainerPathStat{Name: file}, nil
+		}
+	}
+	return types.ContainerPathStat{}, errdefs.NotFound(errors.New("no such file"))
+}
+
+func (cli *imageFilesClient) ContainerRemove(ctx context.Context, containerID string, options types.ContainerRemoveOptions) error {
+	cli.removed = true
+	return nil
+}
+
+func TestFindHealthCheckClient(t *testing.T) {
+	cli := &imageFilesClient{files: []string{"/bin/wget"}}
+	httpClient, err := findHealthCheckClient(context.Background(), cli, "alpine")
+	assert.NoError(t, err)
+	assert.Equal(t, "/bin/wget", httpClient)
+	assert.True(t, cli.removed)
+
+	// distroless images have neither curl nor wget
+	cli = &imageFilesClient{}
+	httpClient, err = findHealthCheckClient(context.Background(), cli, "gcr.io/distroless/static")
+	assert.NoError(t, err)
+	assert.Empty(t, httpClient)
+	assert.True(t, cli.removed)
+}
+
+func TestHealthLogSince(t *testing.T) {
+	start := time.Date(2022, 11, 1, 12, 0, 0, 0, time.UTC)
+	health := &types.Health{Log: []*types.HealthcheckResult{
+		{End: start.Add(time.Second), ExitCode: 1, Output: "connection refused\n"},
+		{End: start.Add(2 * time.Second), ExitCode: 0, Output: ""},
+	}}
+
+	messages, last := healthLogSince(health, time.Time{})
+	assert.Equal(t, []string{"Health check exited with 1: connection refused", "Health check exited with 0: "}, messages)
+	assert.Equal(t, start.Add(2*time.Second), last)
+
+	messages, last = healthLogSince(health, last)
+	assert.Empty(t, messages)
+	assert.Equal(t, start.Add(2*time.Second), last)
+}
@@ -0,0 +1,275 @@
+package utils
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/filters"
+	"github.com/docker/docker/client"
+	"github.com/rs/zerolog/log"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/dyrector-io/dyrectorio/golang/internal/grpc"
+	"github.com/dyrector-io/dyrectorio/golang/pkg/dagent/config"
+	dockerHelper "github.com/dyrector-io/dyrectorio/golang/pkg/helper/docker"
+	"github.com/dyrector-io/dyrectorio/protobuf/go/agent"
+)
+
+// the tag of the images without one
+const danglingImageTag = "<none>:<none>"
+
+// imageUsage records when the images were last pulled or deployed, the build time of an image tells nothing
+// about its use. The images without a record count as used when dagent started, which was their last chance.
+type imageUsage struct {
+	mutex   sync.Mutex
+	used    map[string]time.Time
+	started time.Time
+}
+
+var usedImages = newImageUsage(time.Now())
+
+func newImageUsage(started time.Time) *imageUsage {
+	return &imageUsage{used: map[string]time.Time{}, started: started}
+}
+
+func (usage *imageUsage) touch(imageID string, at time.Time) {
+	usage.mutex.Lock()
+	defer usage.mutex.Unlock()
+	usage.used[imageID] = at
+}
+
+func (usage *imageUsage) lastUse(image *types.ImageSummary) time.Time {
+	usage.mutex.Lock()
+	defer usage.mutex.Unlock()
+
+	if used, ok := usage.used[image.ID]; ok {
+		return used
+	}
+
+	created := time.Unix(image.Created, 0)
+	if created.After(usage.started) {
+		return created
+	}
+	return usage.started
+}
+
+// recordImageUse marks the image as used by a deployment, it is not pruned until the min age passes
+func recordImageUse(ctx context.Context, image string) error {
+	cli, err := client.NewClientWithOpts(client.FromEnv, client.WithAPIVersionNegotiation())
+	if err != nil {
+		return err
+	}
+	defer cli.Close()
+
+	inspect, _, err := cli.ImageInspectWithRaw(ctx, image)
+	if err != nil {
+		return err
+	}
+
+	usedImages.touch(inspect.ID, time.Now())
+	return nil
+}
+
+type imageRetention struct {
+	keepTags int
+	minAge   time.Duration
+}
+
+func imageRetentionOf(req *agent.ImagePruneRequest, cfg *config.Configuration) imageRetention {
+	retention := imageRetention{keepTags: int(cfg.ImageRetentionTags), minAge: cfg.ImageRetentionMinAge}
+	if req.KeepTags != nil {
+		retention.keepTags = int(req.GetKeepTags())
+	}
+	if req.MinAge != nil {
+		retention.minAge = req.GetMinAge().AsDuration()
+	}
+
+	return retention
+}
+
+// ImagePrune removes the images outside of the retention, a dry run only lists them
+func ImagePrune(ctx context.Context, req *agent.ImagePruneRequest) ([]*agent.PrunedImage, error) {
+	cfg := grpc.GetConfigFromContext(ctx).(*config.Configuration)
+
+	return pruneImages(ctx, imageRetentionOf(req, cfg), req.GetDryRun())
+}
+
+// PeriodicImagePrune prunes the images with the configured retention, it runs as a periodic task of the scheduler
+func PeriodicImagePrune(ctx context.Context) error {
+	cfg := grpc.GetConfigFromContext(ctx).(*config.Configuration)
+
+	pruned, err := pruneImages(ctx, imageRetentionOf(&agent.ImagePruneRequest{}, cfg), false)
+	if err != nil {
+		return fmt.Errorf("failed to prune images: %w", err)
+	}
+
+	log.Info().Int("count", len(pruned)).Msg("Pruned images")
+	return nil
+}
+
+func pruneImages(ctx context.Context, retention imageRetention, dryRun bool) ([]*agent.PrunedImage, error) {
+	cli, err := client.NewClientWithOpts(client.FromEnv, client.WithAPIVersionNegotiation())
+	if err != nil {
+		return nil, err
+	}
+	defer cli.Close()
+
+	images, err := cli.ImageList(ctx, types.ImageListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("could not list images: %w", err)
+	}
+
+	containers, err := dockerHelper.GetAllContainersByLabel(ctx, LabelDyrectorioOrg+LabelContainerPrefix)
+	if err != nil {
+		return nil, fmt.Errorf("could not list containers: %w", err)
+	}
+
+	usedImageIDs := map[string]bool{}
+	for i := range containers {
+		usedImageIDs[containers[i].ImageID] = true
+	}
+
+	// images built on top of the pruned one would make the removal fail after untagging it
+	parentImageIDs := map[string]bool{}
+	for i := range images {
+		if images[i].ParentID != "" {
+			parentImageIDs[images[i].ParentID] = true
+		}
+	}
+
+	pruned := []*agent.PrunedImage{}
+	for _, image := range selectPrunableImages(images, usedImageIDs, usedImages, retention, time.Now()) {
+		if !dryRun {
+			if err := removeImage(ctx, cli, &image, parentImageIDs[image.ID]); err != nil {
+				// eg. used by a container without the prefix label
+				log.Warn().Err(err).Str("imageId", image.ID).Strs("tags", image.RepoTags).Msg("Failed to remove image")
+				continue
+			}
+			log.Info().Str("imageId", image.ID).Strs("tags", image.RepoTags).Msg("Removed image")
+		}
+
+		pruned = append(pruned, &agent.PrunedImage{
+			Id:        image.ID,
+			Size:      uint64(image.Size),
+			CreatedAt: timestamppb.New(time.Unix(image.Created, 0)),
+			RepoTags:  imageTags(&image),
+		})
+	}
+
+	return pruned, nil
+}
+
+// removeImage untags the image in every repository, the last one removes it,
+// removing by id fails if the image has more than one repository. The image is checked before the first tag
+// is removed, a conflict in the middle would leave the image behind without some of its tags.
+func removeImage(ctx context.Context, cli client.APIClient, image *types.ImageSummary, hasChildren bool) error {
+	if hasChildren {
+		return errors.New("image has dependent child images")
+	}
+
+	// any container, not only the ones with the prefix label, the filter matches the descendant images too
+	containers, err := cli.ContainerList(ctx, types.ContainerListOptions{
+		All:     true,
+		Filters: filters.NewArgs(filters.Arg("ancestor", image.ID)),
+	})
+	if err != nil {
+		return fmt.Errorf("could not list the containers of the image: %w", err)
+	}
+	if len(containers) > 0 {
+		return fmt.Errorf("image is used by container %s", strings.Join(containers[0].Names, ","))
+	}
+
+	tags := imageTags(image)
+	if len(tags) == 0 {
+		tags = []string{image.ID}
+	}
+
+	for _, tag := range tags {
+		if _, err := cli.ImageRemove(ctx, tag, types.ImageRemoveOptions{PruneChildren: true}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// selectPrunableImages returns the unused images not used for the min age, which are not
+// among the most recently used tags of any of their repositories, the least recently used first
+func selectPrunableImages(images []types.ImageSummary, usedImageIDs map[string]bool, usage *imageUsage,
+	retention imageRetention, now time.Time,
+) []types.ImageSummary {
+	lastUse := map[string]time.Time{}
+	kept := map[string]bool{}
+	byRepository := map[string][]*types.ImageSummary{}
+
+	for i := range images {
+		image := &images[i]
+		lastUse[image.ID] = usage.lastUse(image)
+		if usedImageIDs[image.ID] {
+			kept[image.ID] = true
+		}
+
+		repositories := map[string]bool{}
+		for _, tag := range imageTags(image) {
+			repositories[imageRepository(tag)] = true
+		}
+		for repository := range repositories {
+			byRepository[repository] = append(byRepository[repository], image)
+		}
+	}
+
+	for _, repositoryImages := range byRepository {
+		sort.Slice(repositoryImages, func(i, j int) bool {
+			left, right := lastUse[repositoryImages[i].ID], lastUse[repositoryImages[j].ID]
+			if left.Equal(right) {
+				return repositoryImages[i].ID < repositoryImages[j].ID
+			}
+			return left.After(right)
+		})
+
+		for i := 0; i < retention.keepTags && i < len(repositoryImages); i++ {
+			kept[repositoryImages[i].ID] = true
+		}
+	}
+
+	threshold := now.Add(-retention.minAge)
+	prunable := []types.ImageSummary{}
+	for i := range images {
+		if !kept[images[i].ID] && !lastUse[images[i].ID].After(threshold) {
+			prunable = append(prunable, images[i])
+		}
+	}
+
+	sort.SliceStable(prunable, func(i, j int) bool {
+		return lastUse[prunable[i].ID].Before(lastUse[prunable[j].ID])
+	})
+
+	return prunable
+}
+
+func imageTags(image *types.ImageSummary) []string {
+	tags := []string{}
+	for _, tag := range image.RepoTags {
+		if tag != danglingImageTag {
+			tags = append(tags, tag)
+		}
+	}
+
+	return tags
+}
+
+// imageRepository strips the tag, the registry may have a port
+func imageRepository(tag string) string {
+	index := strings.LastIndex(tag, ":")
+	if index > strings.LastIndex(tag, "/") {
+		return tag[:index]
+	}
+
+	return tag
+}
@@ -0,0 +1,87 @@
+//go:build unit
+// +build unit
+
+package utils
+
+import (
+	"testing"
+	"time"
+
+	"github.com/docker/docker/api/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestImageRepository(t *testing.T) {
+	assert.Equal(t, "nginx", imageRepository("nginx:latest"))
+	assert.Equal(t, "ghcr.io/dyrector-io/dagent", imageRepository("ghcr.io/dyrector-io/dagent:1.0"))
+	assert.Equal(t, "localhost:5000/app", imageRepository("localhost:5000/app:2"))
+	assert.Equal(t, "localhost:5000/app", imageRepository("localhost:5000/app"))
+}
+
+func TestSelectPrunableImages(t *testing.T) {
+	now := time.Unix(1_000_000, 0)
+	day := int64(24 * 60 * 60)
+	images := []types.ImageSummary{
+		{ID: "app-4", RepoTags: []string{"app:4"}, Created: now.Unix() - day},
+		{ID: "app-3", RepoTags: []string{"app:3"}, Created: now.Unix() - 2*day},
+		{ID: "app-2", RepoTags: []string{"app:2"}, Created: now.Unix() - 3*day},
+		{ID: "app-1", RepoTags: []string{"app:1"}, Created: now.Unix() - 4*day},
+		{ID: "app-0", RepoTags: []string{"app:0"}, Created: now.Unix() - 5*day},
+		{ID: "dangling", RepoTags: []string{danglingImageTag}, Created: now.Unix() - 6*day},
+	}
+
+	prunable := selectPrunableImages(images, map[string]bool{"app-0": true}, newImageUsage(time.Unix(0, 0)),
+		imageRetention{keepTags: 2, minAge: 80 * time.Hour}, now)
+
+	ids := []string{}
+	for i := range prunable {
+		ids = append(ids, prunable[i].ID)
+	}
+	// the newest two are kept, app-0 is in use and app-2 is not old enough
+	assert.Equal(t, []string{"dangling", "app-1"}, ids)
+}
+
+func TestSelectPrunableImagesKeepsImageTaggedInRecentRepository(t *testing.T) {
+	now := time.Unix(1_000_000, 0)
+	images := []types.ImageSummary{
+		{ID: "new", RepoTags: []string{"app:2"}, Created: now.Unix() - 10},
+		{ID: "shared", RepoTags: []string{"app:1", "mirror/app:1"}, Created: now.Unix() - 20},
+	}
+
+	prunable := selectPrunableImages(images, map[string]bool{}, newImageUsage(time.Unix(0, 0)), imageRetention{keepTags: 1}, now)
+
+	assert.Empty(t, prunable)
+}
+
+func TestSelectPrunableImagesByLastUse(t *testing.T) {
+	now := time.Unix(1_000_000, 0)
+	day := int64(24 * 60 * 60)
+	images := []types.ImageSummary{
+		{ID: "app-2", RepoTags: []string{"app:2"}, Created: now.Unix() - 10*day},
+		{ID: "app-1", RepoTags: []string{"app:1"}, Created: now.Unix() - 20*day},
+		{ID: "app-0", RepoTags: []string{"app:0"}, Created: now.Unix() - 30*day},
+		{ID: "cache", RepoTags: []string{"cache:1"}, Created: now.Unix() - 30*day},
+	}
+
+	// dagent started two days ago, app-0 was deployed again an hour ago
+	usage := newImageUsage(now.Add(-48 * time.Hour))
+	usage.touch("app-0", now.Add(-time.Hour))
+	usage.touch("app-1", now.Add(-72*time.Hour))
+
+	prunable := selectPrunableImages(images, map[string]bool{}, usage, imageRetention{keepTags: 1, minAge: 24 * time.Hour}, now)
+
+	ids := []string{}
+	for i := range prunable {
+		ids = append(ids, prunable[i].ID)
+	}
+	// app-0 is the most recently used tag, the rest were not used since dagent started
+	assert.Equal(t, []string{"app-1", "app-2"}, ids)
+
+	prunable = selectPrunableImages(images, map[string]bool{}, usage, imageRetention{keepTags: 1, minAge: 60 * time.Hour}, now)
+	ids = []string{}
+	for i := range prunable {
+		ids = append(ids, prunable[i].ID)
+	}
+	// the images without a record are not older than the start of dagent
+	assert.Equal(t, []string{"app-1"}, ids)
+}
@@ -43,7 +43,9 @@ func spawnInitContainer(
 	builder := containerbuilder.NewDockerBuilder(ctx)
 
 	importContainerName := util.JoinV("-", name, "import")
-	targetVolume := mount.Mount{Type: mount.TypeBind, Source: mountList[targetVolumeIndex].Source, Target: "/data/output"}
+	targetVolume := mountList[targetVolumeIndex]
+	targetVolume.Target = "/data/output"
+	targetVolume.ReadOnly = false
 
 	builder, err = builder.
 		WithClient(cli).
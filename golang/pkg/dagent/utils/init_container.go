@@ -0,0 +1,156 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/api/types/mount"
+	"github.com/docker/docker/client"
+	"github.com/docker/docker/pkg/stdcopy"
+	"github.com/rs/zerolog/log"
+
+	v1 "github.com/dyrector-io/dyrectorio/golang/api/v1"
+	"github.com/dyrector-io/dyrectorio/golang/internal/dogger"
+	"github.com/dyrector-io/dyrectorio/golang/internal/util"
+	containerbuilder "github.com/dyrector-io/dyrectorio/golang/pkg/builder/container"
+	"github.com/dyrector-io/dyrectorio/golang/pkg/dagent/config"
+	dockerHelper "github.com/dyrector-io/dyrectorio/golang/pkg/helper/docker"
+)
+
+const configLoaderTarget = "/targetconfig"
+
+// initContainerMounts mounts the linked volumes of the parent container the same way as the parent does,
+// links to undefined volumes are host directories
+func initContainerMounts(links []v1.VolumeLink, parentConfig *v1.ContainerConfig, prefix string,
+	cfg *config.Configuration,
+) []mount.Mount {
+	volumes := []v1.Volume{}
+	for _, link := range links {
+		volume := v1.Volume{Name: link.Name}
+		for i := range parentConfig.Volumes {
+			if parentConfig.Volumes[i].Name == link.Name {
+				volume = parentConfig.Volumes[i]
+				break
+			}
+		}
+
+		volume.Path = link.Path
+		volumes = append(volumes, volume)
+	}
+
+	return volumesToDocker(volumes, prefix, parentConfig.Container, cfg)
+}
+
+// configLoader copies the config files of the config container into the volume, the same way as crane does,
+// the path is an argument of rsync as is, it never goes through a shell
+func configLoader(configContainer *v1.ConfigContainer) *v1.InitContainer {
+	rsync := []string{"rsync", "-a"}
+	if !configContainer.KeepFiles {
+		rsync = append(rsync, "--delete")
+	}
+
+	return &v1.InitContainer{
+		Name:    "config-loader",
+		Image:   configContainer.Image,
+		Command: append(rsync, configContainer.Path, configLoaderTarget),
+		Volumes: []v1.VolumeLink{{Name: configContainer.Volume, Path: configLoaderTarget}},
+	}
+}
+
+// initContainerEnv returns the envs of the init container, the parent's envs and secrets are included
+// if it uses the parent, the directly defined ones take precedence
+func initContainerEnv(initContainer *v1.InitContainer, parentEnv map[string]string) []string {
+	envs := map[string]string{}
+	for key, value := range initContainer.Envs {
+		envs[key] = value
+	}
+
+	if initContainer.UseParent {
+		envs = MergeStringMapUnique(parentEnv, envs)
+	}
+
+	return EnvMapToSlice(envs)
+}
+
+// runInitContainer runs the init container to completion, streaming its output into the deployment log
+func runInitContainer(ctx context.Context, cli *client.Client, containerName string, initContainer *v1.InitContainer,
+	mounts []mount.Mount, envs []string, dog *dogger.DeploymentLogger,
+) error {
+	name := util.JoinV("-", containerName, "init", initContainer.Name)
+	dog.Write("Running init container: " + name)
+
+	builder, err := containerbuilder.NewDockerBuilder(ctx).
+		WithClient(cli).
+		WithImage(initContainer.Image).
+		WithName(name).
+		WithEntrypoint(initContainer.Command).
+		WithCmd(initContainer.Args).
+		WithEnv(envs).
+		WithMountPoints(mounts).
+		WithoutConflict().
+		WithLogWriter(dog).
+		Create()
+	if err != nil {
+		return fmt.Errorf("init container %s create failed: %w", initContainer.Name, err)
+	}
+
+	containerID := *builder.GetContainerID()
+	waitC, errC := cli.ContainerWait(ctx, containerID, container.WaitConditionNextExit)
+	if err = builder.Start(); err != nil {
+		return fmt.Errorf("init container %s start failed: %w", initContainer.Name, err)
+	}
+
+	streamInitContainerLogs(ctx, cli, containerID, initContainer.Name, dog)
+
+	select {
+	case result := <-waitC:
+		if result.StatusCode != 0 {
+			return fmt.Errorf("init container %s exited with code: %v", initContainer.Name, result.StatusCode)
+		}
+	case err = <-errC:
+		return fmt.Errorf("init container %s wait failed: %w", initContainer.Name, err)
+	}
+
+	if err = dockerHelper.DeleteContainerByID(ctx, dog, containerID); err != nil {
+		log.Warn().Err(err).Str("name", name).Msg("Failed to delete init container after completion")
+	}
+
+	return nil
+}
+
+// streamInitContainerLogs follows the output of the container until it exits
+func streamInitContainerLogs(ctx context.Context, cli client.ContainerAPIClient, containerID, name string,
+	dog *dogger.DeploymentLogger,
+) {
+	logs, err := cli.ContainerLogs(ctx, containerID, types.ContainerLogsOptions{
+		ShowStdout: true,
+		ShowStderr: true,
+		Follow:     true,
+	})
+	if err != nil {
+		dog.Write(fmt.Sprintf("Failed to read the logs of init container %s: %s", name, err.Error()))
+		return
+	}
+	defer logs.Close()
+
+	writer := &initContainerLogWriter{dog: dog, name: name}
+	if _, err = stdcopy.StdCopy(writer, writer, logs); err != nil {
+		log.Debug().Err(err).Str("name", name).Msg("Init container log stream closed")
+	}
+}
+
+// initContainerLogWriter writes every line of the init container output as a deployment log entry
+type initContainerLogWriter struct {
+	dog  *dogger.DeploymentLogger
+	name string
+}
+
+func (w *initContainerLogWriter) Write(p []byte) (int, error) {
+	for _, line := range strings.Split(strings.TrimRight(string(p), "\n"), "\n") {
+		w.dog.Write(fmt.Sprintf("[%s] %s", w.name, line))
+	}
+	return len(p), nil
+}
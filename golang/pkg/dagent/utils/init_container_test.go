@@ -0,0 +1,78 @@
+//go:build unit
+// +build unit
+
+package utils
+
+import (
+	"path"
+	"testing"
+
+	"github.com/docker/docker/api/types/mount"
+	"github.com/stretchr/testify/assert"
+
+	v1 "github.com/dyrector-io/dyrectorio/golang/api/v1"
+	"github.com/dyrector-io/dyrectorio/golang/pkg/dagent/config"
+)
+
+func TestInitContainerEnv(t *testing.T) {
+	parentEnv := map[string]string{"DB_HOST": "db", "DB_PASSWORD": "secret"}
+	initContainer := &v1.InitContainer{Envs: map[string]string{"DB_HOST": "migration-db"}}
+
+	assert.Equal(t, []string{"DB_HOST=migration-db"}, initContainerEnv(initContainer, parentEnv))
+
+	initContainer.UseParent = true
+	assert.ElementsMatch(t, []string{"DB_HOST=migration-db", "DB_PASSWORD=secret"}, initContainerEnv(initContainer, parentEnv))
+	assert.Equal(t, map[string]string{"DB_HOST": "migration-db"}, initContainer.Envs)
+}
+
+func TestInitContainerMounts(t *testing.T) {
+	cfg := &config.Configuration{DataMountPath: "/srv/dagent", InternalMountPath: t.TempDir()}
+
+	mounts := initContainerMounts([]v1.VolumeLink{{Name: "data", Path: "/var/lib/data"}}, &v1.ContainerConfig{Container: "name"}, "prefix", cfg)
+
+	assert.Equal(t, []mount.Mount{{
+		Type:   mount.TypeBind,
+		Source: path.Join("/srv/dagent", "prefix", "name", "data"),
+		Target: "/var/lib/data",
+	}}, mounts)
+	assert.DirExists(t, path.Join(cfg.InternalMountPath, "prefix", "name", "data"))
+}
+
+func TestConfigLoader(t *testing.T) {
+	configContainer := &v1.ConfigContainer{Image: "config:latest", Volume: "config", Path: "/config/"}
+
+	loader := configLoader(configContainer)
+	assert.Equal(t, "config:latest", loader.Image)
+	assert.Equal(t, []string{"rsync", "-a", "--delete", "/config/", "/targetconfig"}, loader.Command)
+	assert.Equal(t, []v1.VolumeLink{{Name: "config", Path: "/targetconfig"}}, loader.Volumes)
+
+	configContainer.KeepFiles = true
+	assert.Equal(t, []string{"rsync", "-a", "/config/", "/targetconfig"}, configLoader(configContainer).Command)
+}
+
+func TestConfigLoaderPathIsNotInterpreted(t *testing.T) {
+	loader := configLoader(&v1.ConfigContainer{Image: "config:latest", Volume: "config", Path: "/config/; rm -rf /"})
+
+	assert.Equal(t, []string{"rsync", "-a", "--delete", "/config/; rm -rf /", "/targetconfig"}, loader.Command)
+}
+
+func TestConfigLoaderKeepFilesWritesTheVolumeOfTheParent(t *testing.T) {
+	cfg := &config.Configuration{DataMountPath: "/srv/dagent", InternalMountPath: t.TempDir()}
+	parentConfig := &v1.ContainerConfig{
+		Container: "name",
+		Volumes:   []v1.Volume{{Name: "config", Path: "/app/config", Type: string(v1.ReadWriteOnceVolumeType)}},
+	}
+
+	loader := configLoader(&v1.ConfigContainer{Image: "config:latest", Volume: "config", Path: "/config/", KeepFiles: true})
+	mounts := initContainerMounts(loader.Volumes, parentConfig, "prefix", cfg)
+	parentMounts := volumesToDocker(parentConfig.Volumes, "prefix", "name", cfg)
+
+	// the kept files survive the deployments as the loader writes the same host directory the parent uses
+	assert.Len(t, mounts, 1)
+	assert.Len(t, parentMounts, 1)
+	assert.Equal(t, mount.TypeBind, mounts[0].Type)
+	assert.Equal(t, parentMounts[0].Source, mounts[0].Source)
+	assert.Equal(t, "/targetconfig", mounts[0].Target)
+	assert.False(t, mounts[0].ReadOnly)
+	assert.NotContains(t, loader.Command, "--delete")
+}
@@ -8,6 +8,7 @@
 	LabelDyrectorioOrg   = "org.dyrectorio."
 	LabelSecretKeys      = "secret.keys"
 	LabelContainerPrefix = "container.prefix"
+	LabelImageDigest     = "image.digest"
 )
 
 // generating dyrector.io specific labels for containers
@@ -4,6 +4,7 @@
 	"context"
 	"fmt"
 
+	"github.com/docker/docker/client"
 	"github.com/hashicorp/go-version"
 	"github.com/rs/zerolog/log"
 
@@ -39,3 +40,15 @@ func PreflightChecks(cfg *config.Configuration) {
 			Msg("Server is behind the supported version")
 	}
 }
+
+// DockerPing checks whether the Docker daemon is reachable
+func DockerPing(ctx context.Context) error {
+	cli, err := client.NewClientWithOpts(client.FromEnv, client.WithAPIVersionNegotiation())
+	if err != nil {
+		return err
+	}
+	defer cli.Close()
+
+	_, err = cli.Ping(ctx)
+	return err
+}
@@ -26,6 +26,7 @@ type ReleaseDoc struct {
 type ReleaseContainer struct {
 	Image      string
 	Tag        string
+	Digest     string `yaml:",omitempty"`
 	Successful bool
 }
 
@@ -129,6 +130,7 @@ func mapDeployResponseToRelease(deployResponse v1.DeployVersionResponse) []Relea
 		containers = append(containers, ReleaseContainer{
 			Image:      *deployResponse[i].ImageName,
 			Tag:        deployResponse[i].Tag,
+			Digest:     deployResponse[i].Digest,
 			Successful: deployResponse[i].Started,
 		})
 	}
@@ -13,13 +13,13 @@
 func TestMapDeployResponseToRelease(t *testing.T) {
 	image := "image"
 	deployVersionResponse := v1.DeployVersionResponse{
-		{ImageName: &image, Tag: "test", Started: true},
+		{ImageName: &image, Tag: "test", Digest: "sha256:test", Started: true},
 	}
 
 	result := mapDeployResponseToRelease(deployVersionResponse)
 
 	expected := []ReleaseContainer{
-		{Image: "image", Tag: "test", Successful: true},
+		{Image: "image", Tag: "test", Digest: "sha256:test", Successful: true},
 	}
 
 	assert.Equal(t, expected, result)
@@ -0,0 +1,80 @@
+package utils
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	v1 "github.com/dyrector-io/dyrectorio/golang/api/v1"
+	"github.com/dyrector-io/dyrectorio/golang/internal/util"
+	containerbuilder "github.com/dyrector-io/dyrectorio/golang/pkg/builder/container"
+	"github.com/dyrector-io/dyrectorio/golang/pkg/dagent/config"
+)
+
+const nanoCPUsPerMilliCPU = 1_000_000
+
+// dockerResources are the limits of a container, zero values mean unlimited
+type dockerResources struct {
+	nanoCPUs          int64
+	memory            int64
+	memoryReservation int64
+	memorySwap        int64
+	pidsLimit         *int64
+}
+
+// getDockerResources maps the resource config to Docker limits the same way crane maps them to Kubernetes,
+// missing values fall back to the Docker defaults of the agent, "0" means unlimited
+func getDockerResources(resourceConfig *v1.ResourceConfig, cfg *config.Configuration) (*dockerResources, error) {
+	cpu, err := parseQuantity(util.Fallback(resourceConfig.Limits.CPU, cfg.DockerDefaultLimitsCPU))
+	if err != nil {
+		return nil, fmt.Errorf("invalid CPU limit: %w", err)
+	}
+
+	memory, err := parseQuantity(util.Fallback(resourceConfig.Limits.Memory, cfg.DockerDefaultLimitsMemory))
+	if err != nil {
+		return nil, fmt.Errorf("invalid memory limit: %w", err)
+	}
+
+	memoryReservation, err := parseQuantity(util.Fallback(resourceConfig.Requests.Memory, cfg.DockerDefaultRequestsMemory))
+	if err != nil {
+		return nil, fmt.Errorf("invalid memory request: %w", err)
+	}
+
+	resources := &dockerResources{
+		nanoCPUs:          cpu.MilliValue() * nanoCPUsPerMilliCPU,
+		memory:            memory.Value(),
+		memoryReservation: memoryReservation.Value(),
+	}
+
+	if resources.memory > 0 {
+		if resources.memoryReservation > resources.memory {
+			return nil, fmt.Errorf("memory request %s is greater than the limit %s", memoryReservation.String(), memory.String())
+		}
+
+		// no swap, same as on Kubernetes
+		resources.memorySwap = resources.memory
+	}
+
+	if cfg.DefaultLimitsPids > 0 {
+		pidsLimit := cfg.DefaultLimitsPids
+		resources.pidsLimit = &pidsLimit
+	}
+
+	return resources, nil
+}
+
+func parseQuantity(value string) (resource.Quantity, error) {
+	if value == "" || value == "0" {
+		return resource.Quantity{}, nil
+	}
+	return resource.ParseQuantity(value)
+}
+
+// apply sets the limits on the builder
+func (resources *dockerResources) apply(builder *containerbuilder.DockerContainerBuilder) *containerbuilder.DockerContainerBuilder {
+	return builder.WithNanoCPUs(resources.nanoCPUs).
+		WithMemory(resources.memory).
+		WithMemoryReservation(resources.memoryReservation).
+		WithMemorySwap(resources.memorySwap).
+		WithPidsLimit(resources.pidsLimit)
+}
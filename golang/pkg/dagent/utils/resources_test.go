@@ -0,0 +1,90 @@
+//go:build unit
+// +build unit
+
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	v1 "github.com/dyrector-io/dyrectorio/golang/api/v1"
+	commonConfig "github.com/dyrector-io/dyrectorio/golang/internal/config"
+	"github.com/dyrector-io/dyrectorio/golang/pkg/dagent/config"
+)
+
+func testResourceConfig() *config.Configuration {
+	return &config.Configuration{
+		DockerDefaultLimitsCPU:      "100m",
+		DockerDefaultLimitsMemory:   "128Mi",
+		DockerDefaultRequestsMemory: "64Mi",
+	}
+}
+
+func TestDockerResourcesIgnoreKubernetesDefaults(t *testing.T) {
+	cfg := &config.Configuration{
+		CommonConfiguration: commonConfig.CommonConfiguration{
+			DefaultLimitsCPU:     "100m",
+			DefaultLimitsMemory:  "128Mi",
+			DefaultRequestMemory: "64Mi",
+		},
+		DockerDefaultLimitsCPU:      "0",
+		DockerDefaultLimitsMemory:   "0",
+		DockerDefaultRequestsMemory: "0",
+	}
+
+	resources, err := getDockerResources(&v1.ResourceConfig{}, cfg)
+
+	assert.NoError(t, err)
+	assert.Zero(t, resources.nanoCPUs)
+	assert.Zero(t, resources.memory)
+	assert.Zero(t, resources.memoryReservation)
+	assert.Zero(t, resources.memorySwap)
+}
+
+func TestDockerResourcesDefaults(t *testing.T) {
+	resources, err := getDockerResources(&v1.ResourceConfig{}, testResourceConfig())
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(100_000_000), resources.nanoCPUs)
+	assert.Equal(t, int64(128*1024*1024), resources.memory)
+	assert.Equal(t, int64(64*1024*1024), resources.memoryReservation)
+	assert.Equal(t, resources.memory, resources.memorySwap)
+	assert.Nil(t, resources.pidsLimit)
+}
+
+func TestDockerResourcesFromConfig(t *testing.T) {
+	cfg := testResourceConfig()
+	cfg.DefaultLimitsPids = 100
+
+	resources, err := getDockerResources(&v1.ResourceConfig{
+		Limits:   v1.Resources{CPU: "1.5", Memory: "1G"},
+		Requests: v1.Resources{Memory: "0"},
+	}, cfg)
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1_500_000_000), resources.nanoCPUs)
+	assert.Equal(t, int64(1_000_000_000), resources.memory)
+	assert.Zero(t, resources.memoryReservation)
+	assert.Equal(t, int64(100), *resources.pidsLimit)
+}
+
+func TestDockerResourcesUnlimited(t *testing.T) {
+	resources, err := getDockerResources(&v1.ResourceConfig{Limits: v1.Resources{CPU: "0", Memory: "0"}}, testResourceConfig())
+
+	assert.NoError(t, err)
+	assert.Zero(t, resources.nanoCPUs)
+	assert.Zero(t, resources.memory)
+	assert.Zero(t, resources.memorySwap)
+}
+
+func TestDockerResourcesInvalid(t *testing.T) {
+	_, err := getDockerResources(&v1.ResourceConfig{Limits: v1.Resources{CPU: "lots"}}, testResourceConfig())
+	assert.Error(t, err)
+
+	_, err = getDockerResources(&v1.ResourceConfig{
+		Limits:   v1.Resources{Memory: "64Mi"},
+		Requests: v1.Resources{Memory: "128Mi"},
+	}, testResourceConfig())
+	assert.Error(t, err)
+}
@@ -0,0 +1,138 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/network"
+	"github.com/docker/docker/client"
+
+	"github.com/dyrector-io/dyrectorio/golang/internal/dogger"
+	"github.com/dyrector-io/dyrectorio/golang/internal/grpc"
+	"github.com/dyrector-io/dyrectorio/golang/internal/tracing"
+	dockerHelper "github.com/dyrector-io/dyrectorio/golang/pkg/helper/docker"
+)
+
+// containerSnapshot is everything needed to recreate a removed container
+type containerSnapshot struct {
+	inspect types.ContainerJSON
+}
+
+func snapshotContainer(ctx context.Context, cli client.ContainerAPIClient, containerID string) (*containerSnapshot, error) {
+	inspect, err := cli.ContainerInspect(ctx, containerID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to inspect the previous container: %w", err)
+	}
+
+	if inspect.Config == nil || inspect.HostConfig == nil {
+		return nil, fmt.Errorf("incomplete inspect data of the previous container: %s", containerID)
+	}
+
+	// the secret files are mounted from the directory of the previous deployment, which is kept on failure
+	return &containerSnapshot{inspect: inspect}, nil
+}
+
+// endpoints returns the network settings of the snapshot, without the runtime values assigned by Docker
+func (snapshot *containerSnapshot) endpoints() map[string]*network.EndpointSettings {
+	endpoints := map[string]*network.EndpointSettings{}
+	if snapshot.inspect.NetworkSettings == nil {
+		return endpoints
+	}
+
+	shortID := snapshot.inspect.ID
+	if len(shortID) > 12 {
+		shortID = shortID[:12]
+	}
+
+	for name, settings := range snapshot.inspect.NetworkSettings.Networks {
+		if settings == nil {
+			continue
+		}
+
+		// docker adds the short id of the container as an alias, it would point to nothing
+		aliases := []string{}
+		for _, alias := range settings.Aliases {
+			if alias != shortID {
+				aliases = append(aliases, alias)
+			}
+		}
+
+		endpoints[name] = &network.EndpointSettings{
+			IPAMConfig: settings.IPAMConfig,
+			Links:      settings.Links,
+			Aliases:    aliases,
+			NetworkID:  settings.NetworkID,
+		}
+	}
+
+	return endpoints
+}
+
+// restore recreates and starts the container of the snapshot from the exact image it was running
+func (snapshot *containerSnapshot) restore(ctx context.Context, cli client.APIClient) (err error) {
+	ctx, span := tracing.Start(ctx, "restore container", tracing.ContainerKey.String(snapshot.name()))
+	defer func() { tracing.End(span, err) }()
+
+	config := *snapshot.inspect.Config
+	config.Image = snapshot.inspect.Image
+
+	endpoints := snapshot.endpoints()
+	primary := string(snapshot.inspect.HostConfig.NetworkMode)
+	if snapshot.inspect.HostConfig.NetworkMode.IsDefault() {
+		primary = "bridge"
+	}
+
+	// only one network can be given on create, the rest are connected afterwards
+	networkingConfig := &network.NetworkingConfig{EndpointsConfig: map[string]*network.EndpointSettings{}}
+	if settings, ok := endpoints[primary]; ok {
+		networkingConfig.EndpointsConfig[primary] = settings
+		delete(endpoints, primary)
+	}
+
+	created, err := cli.ContainerCreate(ctx, &config, snapshot.inspect.HostConfig, networkingConfig, nil, snapshot.name())
+	if err != nil {
+		return fmt.Errorf("failed to recreate the previous container: %w", err)
+	}
+
+	for name, settings := range endpoints {
+		if err = cli.NetworkConnect(ctx, name, created.ID, settings); err != nil {
+			return fmt.Errorf("failed to connect the previous container to network %s: %w", name, err)
+		}
+	}
+
+	if err = cli.ContainerStart(ctx, created.ID, types.ContainerStartOptions{}); err != nil {
+		return fmt.Errorf("failed to start the previous container: %w", err)
+	}
+
+	return nil
+}
+
+func (snapshot *containerSnapshot) name() string {
+	return strings.TrimPrefix(snapshot.inspect.Name, "/")
+}
+
+// rollback replaces the failed new container with the previous one,
+// the returned error tells crux that the deployment was downgraded
+func rollback(dog *dogger.DeploymentLogger, cli client.APIClient, snapshot *containerSnapshot, failedID *string, cause error) error {
+	// the deployment context might be canceled, which is one of the reasons to roll back
+	ctx := context.Background()
+
+	dog.Write(fmt.Sprintf("Deployment failed, rolling back to the previous container: %s", cause.Error()))
+
+	if failedID != nil {
+		if err := dockerHelper.DeleteContainerByID(ctx, dog, *failedID); err != nil {
+			dog.Write(fmt.Sprintf("Failed to remove the new container: %s", err.Error()))
+			return cause
+		}
+	}
+
+	if err := snapshot.restore(ctx, cli); err != nil {
+		dog.Write(fmt.Sprintf("Rollback failed: %s", err.Error()))
+		return cause
+	}
+
+	dog.WriteContainerState("running", "Previous container restored: "+snapshot.name())
+	return &grpc.DowngradedError{Err: cause}
+}
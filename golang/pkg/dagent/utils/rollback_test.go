@@ -0,0 +1,30 @@
+//go:build unit
+// +build unit
+
+package utils
+
+import (
+	"testing"
+
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/network"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSnapshotEndpointsDropTheShortIDAlias(t *testing.T) {
+	snapshot := &containerSnapshot{inspect: types.ContainerJSON{
+		ContainerJSONBase: &types.ContainerJSONBase{ID: "0123456789abcdef", Name: "/prefix-name"},
+		NetworkSettings: &types.NetworkSettings{Networks: map[string]*network.EndpointSettings{
+			"prefix": {NetworkID: "net-id", Aliases: []string{"prefix-name", "name", "0123456789ab"}, IPAddress: "172.18.0.2"},
+			"empty":  nil,
+		}},
+	}}
+
+	endpoints := snapshot.endpoints()
+
+	assert.Len(t, endpoints, 1)
+	assert.Equal(t, []string{"prefix-name", "name"}, endpoints["prefix"].Aliases)
+	assert.Equal(t, "net-id", endpoints["prefix"].NetworkID)
+	assert.Empty(t, endpoints["prefix"].IPAddress)
+	assert.Equal(t, "prefix-name", snapshot.name())
+}
@@ -0,0 +1,207 @@
+package utils
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/docker/docker/api/types/mount"
+	"github.com/docker/docker/client"
+	"github.com/rs/zerolog/log"
+	"golang.org/x/exp/slices"
+
+	v1 "github.com/dyrector-io/dyrectorio/golang/api/v1"
+	"github.com/dyrector-io/dyrectorio/golang/internal/dogger"
+	"github.com/dyrector-io/dyrectorio/golang/pkg/dagent/config"
+)
+
+// Secrets delivered as files are written to a directory on a host tmpfs, which is bind-mounted read-only
+// into the container. Every deployment gets its own directory, so the previous container keeps its secrets
+// until the new one replaces it. The secrets are not visible in the environment or in the inspect output.
+const secretFilesPath = "/run/secrets"
+
+// file permissions: only the owner can read the secrets
+const (
+	secretFileMode         = 0o400
+	secretDirectoryMode    = 0o500
+	secretDirectoryPattern = "deployment-"
+)
+
+// splitSecretFiles separates the secrets written to files from the ones passed as env variables
+func splitSecretFiles(secrets map[string][]byte, fileKeys []string) (envSecrets, fileSecrets map[string][]byte, err error) {
+	envSecrets = map[string][]byte{}
+	fileSecrets = map[string][]byte{}
+
+	for _, key := range fileKeys {
+		if key == "" || key == "." || key == ".." || strings.ContainsAny(key, `/\`) {
+			return nil, nil, fmt.Errorf("invalid secret file name: %q", key)
+		}
+	}
+
+	for key, value := range secrets {
+		if slices.Contains(fileKeys, key) {
+			fileSecrets[key] = value
+		} else {
+			envSecrets[key] = value
+		}
+	}
+
+	return envSecrets, fileSecrets, nil
+}
+
+// prepareSecretFiles writes the secret files of the deployment, they are owned by the user the container runs as
+func prepareSecretFiles(ctx context.Context, dog *dogger.DeploymentLogger, cfg *config.Configuration,
+	deployImageRequest *v1.DeployImageRequest, image string, secrets map[string][]byte,
+) (secretMount mount.Mount, dir string, err error) {
+	imageUser := ""
+	if deployImageRequest.ContainerConfig.User == nil {
+		cli, err := client.NewClientWithOpts(client.FromEnv, client.WithAPIVersionNegotiation())
+		if err != nil {
+			return secretMount, "", err
+		}
+		defer cli.Close()
+
+		inspect, _, err := cli.ImageInspectWithRaw(ctx, image)
+		if err != nil {
+			return secretMount, "", fmt.Errorf("failed to inspect the user of the image: %w", err)
+		}
+		if inspect.Config != nil {
+			imageUser = inspect.Config.User
+		}
+	}
+
+	uid, ok := secretFilesOwner(deployImageRequest.ContainerConfig.User, imageUser)
+	if !ok {
+		dog.Write(fmt.Sprintf("The user of the image is not numeric (%s), the secret files are readable by root only", imageUser))
+	}
+
+	return writeSecretFiles(cfg, deployImageRequest.InstanceConfig.ContainerPreName,
+		deployImageRequest.ContainerConfig.Container, secrets, uid)
+}
+
+// secretFilesOwner is the user of the container, the user of the image if it is numeric, otherwise root
+func secretFilesOwner(user *int64, imageUser string) (uid int, ok bool) {
+	if user != nil {
+		return int(*user), true
+	}
+
+	if imageUser == "" {
+		return 0, true
+	}
+
+	// user[:group]
+	name, _, _ := strings.Cut(imageUser, ":")
+	uid, err := strconv.Atoi(name)
+	if err != nil || uid < 0 {
+		return 0, false
+	}
+
+	return uid, true
+}
+
+// writeSecretFiles writes the secrets to a new deployment directory of the container,
+// the returned mount points to it on the host
+func writeSecretFiles(cfg *config.Configuration, prefix, name string, secrets map[string][]byte, uid int) (
+	secretMount mount.Mount, dir string, err error,
+) {
+	containerDir, err := secretFilesDirectory(cfg, prefix, name)
+	if err != nil {
+		return secretMount, "", err
+	}
+	if err = os.MkdirAll(containerDir, os.ModeDir|0o700); err != nil {
+		return secretMount, "", fmt.Errorf("failed to create the secret directory: %w", err)
+	}
+
+	dir, err = os.MkdirTemp(containerDir, secretDirectoryPattern)
+	if err != nil {
+		return secretMount, "", fmt.Errorf("failed to create the secret directory: %w", err)
+	}
+
+	defer func() {
+		if err != nil {
+			removeSecretDirectory(dir)
+		}
+	}()
+
+	for key, value := range secrets {
+		file := filepath.Join(dir, key)
+		if err = os.WriteFile(file, value, secretFileMode); err != nil {
+			return secretMount, dir, fmt.Errorf("failed to write secret file %s: %w", key, err)
+		}
+		if err = os.Chown(file, uid, 0); err != nil {
+			return secretMount, dir, fmt.Errorf("failed to set the owner of secret file %s: %w", key, err)
+		}
+	}
+
+	if err = os.Chmod(dir, os.ModeDir|secretDirectoryMode); err != nil {
+		return secretMount, dir, fmt.Errorf("failed to set the permissions of the secret directory: %w", err)
+	}
+	if err = os.Chown(dir, uid, 0); err != nil {
+		return secretMount, dir, fmt.Errorf("failed to set the owner of the secret directory: %w", err)
+	}
+
+	secretMount = mount.Mount{
+		Type:     mount.TypeBind,
+		Source:   path.Join(cfg.SecretFilesHostPath, prefix, name, filepath.Base(dir)),
+		Target:   secretFilesPath,
+		ReadOnly: true,
+	}
+
+	return secretMount, dir, nil
+}
+
+// removeSecretFiles removes the secret directories of the container except the one in use,
+// the directories of every container of the prefix if the name is empty
+func removeSecretFiles(cfg *config.Configuration, prefix, name, keep string) {
+	containerDir, err := secretFilesDirectory(cfg, prefix, name)
+	if err != nil {
+		log.Warn().Err(err).Msg("Failed to remove the secret directories")
+		return
+	}
+
+	if keep == "" {
+		removeSecretDirectory(containerDir)
+		return
+	}
+
+	entries, err := os.ReadDir(containerDir)
+	if err != nil {
+		if !errors.Is(err, os.ErrNotExist) {
+			log.Warn().Err(err).Str("path", containerDir).Msg("Failed to list the secret directories")
+		}
+		return
+	}
+
+	for _, entry := range entries {
+		dir := filepath.Join(containerDir, entry.Name())
+		if dir != keep {
+			removeSecretDirectory(dir)
+		}
+	}
+}
+
+// secretFilesDirectory is the directory of the container, or of the prefix if the name is empty
+func secretFilesDirectory(cfg *config.Configuration, prefix, name string) (string, error) {
+	for _, segment := range []string{prefix, name} {
+		if segment == "." || segment == ".." || strings.ContainsAny(segment, `/\`) {
+			return "", fmt.Errorf("invalid secret directory name: %q", segment)
+		}
+	}
+
+	if prefix == "" {
+		return "", errors.New("empty prefix of the secret directory")
+	}
+
+	return filepath.Join(cfg.SecretFilesInternalPath, prefix, name), nil
+}
+
+func removeSecretDirectory(dir string) {
+	if err := os.RemoveAll(dir); err != nil {
+		log.Warn().Err(err).Str("path", dir).Msg("Failed to remove the secret directory")
+	}
+}
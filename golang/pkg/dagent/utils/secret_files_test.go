@@ -0,0 +1,97 @@
+//go:build unit
+// +build unit
+
+package utils
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/docker/docker/api/types/mount"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dyrector-io/dyrectorio/golang/pkg/dagent/config"
+)
+
+func TestSplitSecretFiles(t *testing.T) {
+	secrets := map[string][]byte{"DB_PASSWORD": []byte("secret"), "API_KEY": []byte("key")}
+
+	envSecrets, fileSecrets, err := splitSecretFiles(secrets, []string{"DB_PASSWORD", "MISSING"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, map[string][]byte{"API_KEY": []byte("key")}, envSecrets)
+	assert.Equal(t, map[string][]byte{"DB_PASSWORD": []byte("secret")}, fileSecrets)
+}
+
+func TestSplitSecretFilesInvalidName(t *testing.T) {
+	for _, key := range []string{"", "..", "../etc/passwd", `a\b`} {
+		_, _, err := splitSecretFiles(map[string][]byte{}, []string{key})
+		assert.Error(t, err, key)
+	}
+}
+
+func TestSecretFilesOwner(t *testing.T) {
+	user := int64(1000)
+
+	uid, ok := secretFilesOwner(&user, "2000")
+	assert.True(t, ok)
+	assert.Equal(t, 1000, uid)
+
+	uid, ok = secretFilesOwner(nil, "2000:3000")
+	assert.True(t, ok)
+	assert.Equal(t, 2000, uid)
+
+	uid, ok = secretFilesOwner(nil, "")
+	assert.True(t, ok)
+	assert.Equal(t, 0, uid)
+
+	uid, ok = secretFilesOwner(nil, "node")
+	assert.False(t, ok)
+	assert.Equal(t, 0, uid)
+}
+
+func TestWriteSecretFiles(t *testing.T) {
+	cfg := &config.Configuration{SecretFilesInternalPath: t.TempDir(), SecretFilesHostPath: "/run/dagent/secrets"}
+
+	secretMount, dir, err := writeSecretFiles(cfg, "prefix", "api", map[string][]byte{"DB_PASSWORD": []byte("secret")}, os.Getuid())
+	assert.NoError(t, err)
+
+	assert.Equal(t, mount.TypeBind, secretMount.Type)
+	assert.Equal(t, "/run/dagent/secrets/prefix/api/"+filepath.Base(dir), secretMount.Source)
+	assert.Equal(t, "/run/secrets", secretMount.Target)
+	assert.True(t, secretMount.ReadOnly)
+
+	info, err := os.Stat(filepath.Join(dir, "DB_PASSWORD"))
+	assert.NoError(t, err)
+	assert.Equal(t, os.FileMode(0o400), info.Mode().Perm())
+
+	content, err := os.ReadFile(filepath.Join(dir, "DB_PASSWORD"))
+	assert.NoError(t, err)
+	assert.Equal(t, "secret", string(content))
+}
+
+func TestRemoveSecretFilesKeepsCurrent(t *testing.T) {
+	cfg := &config.Configuration{SecretFilesInternalPath: t.TempDir()}
+	secrets := map[string][]byte{"KEY": []byte("value")}
+
+	_, previous, err := writeSecretFiles(cfg, "prefix", "api", secrets, os.Getuid())
+	assert.NoError(t, err)
+	_, current, err := writeSecretFiles(cfg, "prefix", "api", secrets, os.Getuid())
+	assert.NoError(t, err)
+	assert.NotEqual(t, previous, current)
+
+	removeSecretFiles(cfg, "prefix", "api", current)
+	assert.NoDirExists(t, previous)
+	assert.DirExists(t, current)
+
+	removeSecretFiles(cfg, "prefix", "", "")
+	assert.NoDirExists(t, filepath.Join(cfg.SecretFilesInternalPath, "prefix"))
+}
+
+func TestWriteSecretFilesInvalidName(t *testing.T) {
+	cfg := &config.Configuration{SecretFilesInternalPath: t.TempDir()}
+
+	_, _, err := writeSecretFiles(cfg, "..", "api", map[string][]byte{}, os.Getuid())
+	assert.Error(t, err)
+}
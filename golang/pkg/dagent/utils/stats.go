@@ -0,0 +1,166 @@
+package utils
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/client"
+	"golang.org/x/sync/errgroup"
+
+	dockerHelper "github.com/dyrector-io/dyrectorio/golang/pkg/helper/docker"
+	"github.com/dyrector-io/dyrectorio/protobuf/go/agent"
+	"github.com/dyrector-io/dyrectorio/protobuf/go/common"
+)
+
+// ContainerStats samples the running containers of the request using the Docker stats API
+func ContainerStats(ctx context.Context, req *agent.ContainerStatsRequest) ([]*agent.ContainerStats, error) {
+	containers, err := statsContainers(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	cli, err := client.NewClientWithOpts(client.FromEnv, client.WithAPIVersionNegotiation())
+	if err != nil {
+		return nil, err
+	}
+
+	stats := make([]*agent.ContainerStats, len(containers))
+	// every sample takes a second, the daemon waits for the next CPU reading
+	group, groupCtx := errgroup.WithContext(ctx)
+	for i := range containers {
+		index := i
+		group.Go(func() error {
+			statsJSON, err := dockerContainerStats(groupCtx, cli, containers[index].ID)
+			if err != nil {
+				return err
+			}
+
+			stats[index] = mapDockerStats(statsIdentifier(&containers[index], req.GetPrefix()), statsJSON)
+			return nil
+		})
+	}
+
+	if err = group.Wait(); err != nil {
+		return nil, err
+	}
+
+	return stats, nil
+}
+
+func statsContainers(ctx context.Context, req *agent.ContainerStatsRequest) ([]types.Container, error) {
+	if req.Name != nil {
+		container, err := GetContainerByPrefixAndName(ctx, req.GetPrefix(), req.GetName())
+		if err != nil {
+			return nil, err
+		}
+		if container == nil || container.State != "running" {
+			return []types.Container{}, nil
+		}
+
+		return []types.Container{*container}, nil
+	}
+
+	if req.GetPrefix() == "" {
+		return nil, errors.New("empty prefix for container stats")
+	}
+
+	containers, err := dockerHelper.GetAllContainersByLabel(ctx, getPrefixLabelFilter(req.GetPrefix()))
+	if err != nil {
+		return nil, err
+	}
+
+	running := []types.Container{}
+	for i := range containers {
+		if containers[i].State == "running" {
+			running = append(running, containers[i])
+		}
+	}
+
+	return running, nil
+}
+
+func statsIdentifier(container *types.Container, prefix string) *common.ContainerIdentifier {
+	name := ""
+	if len(container.Names) > 0 {
+		name = strings.TrimPrefix(container.Names[0], "/")
+	}
+	if prefix != "" {
+		name = strings.TrimPrefix(name, prefix+"-")
+	}
+
+	return &common.ContainerIdentifier{Prefix: prefix, Name: name}
+}
+
+func dockerContainerStats(ctx context.Context, cli *client.Client, containerID string) (*types.StatsJSON, error) {
+	response, err := cli.ContainerStats(ctx, containerID, false)
+	if err != nil {
+		return nil, fmt.Errorf("could not get container stats: %w", err)
+	}
+	defer response.Body.Close()
+
+	statsJSON := &types.StatsJSON{}
+	if err = json.NewDecoder(response.Body).Decode(statsJSON); err != nil {
+		return nil, fmt.Errorf("could not decode container stats: %w", err)
+	}
+
+	return statsJSON, nil
+}
+
+// mapDockerStats calculates the usage the same way as the docker stats command
+func mapDockerStats(identifier *common.ContainerIdentifier, statsJSON *types.StatsJSON) *agent.ContainerStats {
+	stats := &agent.ContainerStats{
+		Container:   identifier,
+		CpuPercent:  dockerCPUPercent(statsJSON),
+		MemoryUsage: dockerMemoryUsage(&statsJSON.MemoryStats),
+		MemoryLimit: statsJSON.MemoryStats.Limit,
+	}
+
+	for _, network := range statsJSON.Networks {
+		stats.NetworkRxBytes += network.RxBytes
+		stats.NetworkTxBytes += network.TxBytes
+	}
+
+	for _, entry := range statsJSON.BlkioStats.IoServiceBytesRecursive {
+		switch strings.ToLower(entry.Op) {
+		case "read":
+			stats.BlockReadBytes += entry.Value
+		case "write":
+			stats.BlockWriteBytes += entry.Value
+		}
+	}
+
+	return stats
+}
+
+func dockerCPUPercent(statsJSON *types.StatsJSON) float64 {
+	cpuDelta := float64(statsJSON.CPUStats.CPUUsage.TotalUsage) - float64(statsJSON.PreCPUStats.CPUUsage.TotalUsage)
+	systemDelta := float64(statsJSON.CPUStats.SystemUsage) - float64(statsJSON.PreCPUStats.SystemUsage)
+	if cpuDelta <= 0 || systemDelta <= 0 {
+		return 0
+	}
+
+	onlineCPUs := float64(statsJSON.CPUStats.OnlineCPUs)
+	if onlineCPUs == 0 {
+		onlineCPUs = float64(len(statsJSON.CPUStats.CPUUsage.PercpuUsage))
+	}
+
+	return cpuDelta / systemDelta * onlineCPUs * 100
+}
+
+// the page cache is reclaimable, it is not counted as used
+func dockerMemoryUsage(memoryStats *types.MemoryStats) uint64 {
+	// cgroup v1 and v2 name it differently
+	cache, ok := memoryStats.Stats["total_inactive_file"]
+	if !ok {
+		cache = memoryStats.Stats["inactive_file"]
+	}
+
+	if cache > memoryStats.Usage {
+		return 0
+	}
+	return memoryStats.Usage - cache
+}
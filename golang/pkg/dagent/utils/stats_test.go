@@ -0,0 +1,70 @@
+//go:build unit
+// +build unit
+
+package utils
+
+import (
+	"testing"
+
+	"github.com/docker/docker/api/types"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dyrector-io/dyrectorio/protobuf/go/common"
+)
+
+func TestMapDockerStats(t *testing.T) {
+	statsJSON := &types.StatsJSON{
+		Stats: types.Stats{
+			CPUStats: types.CPUStats{
+				CPUUsage:    types.CPUUsage{TotalUsage: 1500},
+				SystemUsage: 20000,
+				OnlineCPUs:  4,
+			},
+			PreCPUStats: types.CPUStats{
+				CPUUsage:    types.CPUUsage{TotalUsage: 1000},
+				SystemUsage: 10000,
+			},
+			MemoryStats: types.MemoryStats{
+				Usage: 300,
+				Limit: 1000,
+				Stats: map[string]uint64{"inactive_file": 100},
+			},
+			BlkioStats: types.BlkioStats{IoServiceBytesRecursive: []types.BlkioStatEntry{
+				{Op: "read", Value: 10},
+				{Op: "Write", Value: 20},
+				{Op: "Read", Value: 5},
+			}},
+		},
+		Networks: map[string]types.NetworkStats{
+			"eth0": {RxBytes: 1, TxBytes: 2},
+			"eth1": {RxBytes: 3, TxBytes: 4},
+		},
+	}
+	identifier := &common.ContainerIdentifier{Prefix: "prefix", Name: "name"}
+
+	stats := mapDockerStats(identifier, statsJSON)
+
+	assert.Equal(t, identifier, stats.Container)
+	assert.InDelta(t, 20.0, stats.CpuPercent, 0.001)
+	assert.Equal(t, uint64(200), stats.MemoryUsage)
+	assert.Equal(t, uint64(1000), stats.MemoryLimit)
+	assert.Equal(t, uint64(4), stats.NetworkRxBytes)
+	assert.Equal(t, uint64(6), stats.NetworkTxBytes)
+	assert.Equal(t, uint64(15), stats.BlockReadBytes)
+	assert.Equal(t, uint64(20), stats.BlockWriteBytes)
+}
+
+func TestDockerCPUPercentWithoutPreviousSample(t *testing.T) {
+	statsJSON := &types.StatsJSON{Stats: types.Stats{
+		CPUStats: types.CPUStats{CPUUsage: types.CPUUsage{TotalUsage: 1500}, SystemUsage: 20000, OnlineCPUs: 4},
+	}}
+	statsJSON.PreCPUStats.SystemUsage = 20000
+
+	assert.Equal(t, 0.0, dockerCPUPercent(statsJSON))
+}
+
+func TestStatsIdentifier(t *testing.T) {
+	container := &types.Container{Names: []string{"/prefix-name"}}
+
+	assert.Equal(t, &common.ContainerIdentifier{Prefix: "prefix", Name: "name"}, statsIdentifier(container, "prefix"))
+}
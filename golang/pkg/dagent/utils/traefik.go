@@ -2,6 +2,8 @@
 package utils
 
 import (
+	"fmt"
+
 	v1 "github.com/dyrector-io/dyrectorio/golang/api/v1"
 	"github.com/dyrector-io/dyrectorio/golang/internal/util"
 	"github.com/dyrector-io/dyrectorio/golang/pkg/dagent/config"
@@ -33,6 +35,16 @@ func GetTraefikLabels(
 		labels["traefik.http.routers."+serviceName+"-secure.tls.certresolver"] = "le"
 	}
 
+	// blue/green containers run side by side during the switch, the explicit service makes Traefik
+	// balance between the healthy ones instead of seeing two conflicting routers
+	if isBlueGreen(containerConfig) && len(containerConfig.Ports) > 0 {
+		labels["traefik.http.routers."+serviceName+".service"] = serviceName
+		if containerConfig.ExposeTLS {
+			labels["traefik.http.routers."+serviceName+"-secure.service"] = serviceName
+		}
+		labels["traefik.http.services."+serviceName+".loadbalancer.server.port"] = fmt.Sprint(containerConfig.Ports[0].ExposedPort)
+	}
+
 	if containerConfig.IngressUploadLimit != "" {
 		labels["traefik.http.middlewares.limit.buffering.maxRequestBodyBytes"] = containerConfig.IngressUploadLimit
 	}
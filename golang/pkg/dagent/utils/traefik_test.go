@@ -41,3 +41,26 @@ func TestGetTraefikLabels(t *testing.T) {
 	labels := GetTraefikLabels(istanceConfig, containerConfig, cfg)
 	assert.Equal(t, expected, labels)
 }
+
+func TestGetTraefikLabelsBlueGreen(t *testing.T) {
+	istanceConfig := &v1.InstanceConfig{
+		ContainerPreName: "pre",
+	}
+	containerConfig := &v1.ContainerConfig{
+		Container:          "name",
+		Ports:              []container.PortBinding{{ExposedPort: 8888}},
+		DeploymentStrategy: v1.DeploymentStrategyBlueGreen,
+	}
+	cfg := &config.Configuration{}
+
+	expected := map[string]string{
+		"traefik.enable":                                          "true",
+		"traefik.http.routers.pre-name.rule":                      "Host(`name.pre.`)",
+		"traefik.http.routers.pre-name.entrypoints":               "web",
+		"traefik.http.routers.pre-name.service":                   "pre-name",
+		"traefik.http.services.pre-name.loadbalancer.server.port": "8888",
+	}
+
+	labels := GetTraefikLabels(istanceConfig, containerConfig, cfg)
+	assert.Equal(t, expected, labels)
+}
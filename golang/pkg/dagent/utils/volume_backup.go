@@ -0,0 +1,545 @@
+package utils
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/mount"
+	"github.com/docker/docker/client"
+	"github.com/docker/docker/errdefs"
+	"github.com/rs/zerolog/log"
+
+	"github.com/dyrector-io/dyrectorio/golang/internal/dogger"
+	"github.com/dyrector-io/dyrectorio/golang/internal/grpc"
+	"github.com/dyrector-io/dyrectorio/golang/internal/util"
+	containerbuilder "github.com/dyrector-io/dyrectorio/golang/pkg/builder/container"
+	"github.com/dyrector-io/dyrectorio/golang/pkg/dagent/config"
+	dockerHelper "github.com/dyrector-io/dyrectorio/golang/pkg/helper/docker"
+	"github.com/dyrector-io/dyrectorio/protobuf/go/agent"
+	"github.com/dyrector-io/dyrectorio/protobuf/go/common"
+)
+
+const (
+	// progress of the archive is reported after every this many bytes
+	volumeProgressInterval = 8 * 1024 * 1024
+	// directory of the archives copied by rclone, next to the volumes of the container
+	volumeStagingDir  = ".dyo-volume-operation"
+	volumeArchiveName = "archive.tar.gz"
+	rcloneArchivePath = "/backup/" + volumeArchiveName
+	// the container is started after the volume operation even if the request was canceled
+	volumeRestartTimeout = time.Minute
+)
+
+// BackupVolume writes the volume of the container as a gzip compressed tar archive,
+// the archive is nil if it is uploaded to the rclone remote of the request
+func BackupVolume(ctx context.Context, volLog *dogger.VolumeOperationLogger, req *agent.VolumeBackupRequest,
+	archive io.Writer,
+) error {
+	cfg := grpc.GetConfigFromContext(ctx).(*config.Configuration)
+	target := req.GetContainer()
+
+	volumeDir, err := volumeDirectory(ctx, cfg, target, req.GetVolume())
+	if err != nil {
+		return err
+	}
+
+	restart, err := stopContainerForVolumeOperation(ctx, volLog, target, req.GetStopContainer())
+	if err != nil {
+		return err
+	}
+	defer restart()
+
+	if req.Remote == nil {
+		volLog.Write("Archiving volume: " + req.GetVolume())
+		return archiveVolume(volumeDir, archive, volLog)
+	}
+
+	stagingDir, err := createVolumeStagingDir(cfg, target, req.GetId())
+	if err != nil {
+		return err
+	}
+	defer removeVolumeStagingDir(stagingDir)
+
+	volLog.Write("Archiving volume: " + req.GetVolume())
+	archiveFile, err := os.Create(path.Join(stagingDir, volumeArchiveName))
+	if err != nil {
+		return fmt.Errorf("could not create the archive: %w", err)
+	}
+
+	err = archiveVolume(volumeDir, archiveFile, volLog)
+	if closeErr := archiveFile.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return err
+	}
+
+	volLog.Write("Uploading archive to: " + req.GetRemote())
+	return runRclone(ctx, volLog, cfg, target, req.GetId(),
+		[]string{"copyto", rcloneArchivePath, req.GetRemote()}, req.GetEnvironment())
+}
+
+// RestoreVolume replaces the content of the volume with a gzip compressed tar archive,
+// the archive is nil if it is downloaded from the rclone remote of the request
+func RestoreVolume(ctx context.Context, volLog *dogger.VolumeOperationLogger, req *agent.VolumeRestoreRequest,
+	archive io.Reader,
+) error {
+	cfg := grpc.GetConfigFromContext(ctx).(*config.Configuration)
+	target := req.GetContainer()
+
+	volumeDir, err := volumeDirectory(ctx, cfg, target, req.GetVolume())
+	if err != nil {
+		return err
+	}
+
+	if req.Remote != nil {
+		stagingDir, stagingErr := createVolumeStagingDir(cfg, target, req.GetId())
+		if stagingErr != nil {
+			return stagingErr
+		}
+		defer removeVolumeStagingDir(stagingDir)
+
+		volLog.Write("Downloading archive from: " + req.GetRemote())
+		err = runRclone(ctx, volLog, cfg, target, req.GetId(),
+			[]string{"copyto", req.GetRemote(), rcloneArchivePath}, req.GetEnvironment())
+		if err != nil {
+			return err
+		}
+
+		archiveFile, openErr := os.Open(path.Join(stagingDir, volumeArchiveName))
+		if openErr != nil {
+			return fmt.Errorf("could not open the archive: %w", openErr)
+		}
+		defer archiveFile.Close()
+
+		archive = archiveFile
+	}
+
+	restart, err := stopContainerForVolumeOperation(ctx, volLog, target, req.GetStopContainer())
+	if err != nil {
+		return err
+	}
+	defer restart()
+
+	volLog.Write("Extracting archive to volume: " + req.GetVolume())
+	return restoreVolume(volumeDir, archive, volLog)
+}
+
+// volumeDirectory is the directory of a bind mounted volume as seen by dagent, named volumes are managed
+// by their driver and the tmp and mem volumes live in memory, they can not be backed up or restored
+func volumeDirectory(ctx context.Context, cfg *config.Configuration, target *common.ContainerIdentifier, volume string) (string, error) {
+	if !isPathSegment(volume) {
+		return "", fmt.Errorf("invalid volume name: %q", volume)
+	}
+
+	if cfg.NamedVolumes {
+		isNamed, err := isNamedVolume(ctx, util.JoinV("-", target.GetPrefix(), target.GetName(), volume))
+		if err != nil {
+			return "", err
+		}
+		if isNamed {
+			return "", fmt.Errorf("volume %s is a named volume, only bind mounted volumes can be backed up or restored", volume)
+		}
+	}
+
+	volumeDir := path.Join(cfg.InternalMountPath, target.GetPrefix(), target.GetName(), volume)
+	info, err := os.Stat(volumeDir)
+	if errors.Is(err, os.ErrNotExist) {
+		return "", fmt.Errorf("volume %s has no directory on the host, tmp and mem volumes can not be backed up or restored", volume)
+	}
+	if err != nil {
+		return "", fmt.Errorf("volume directory not found: %w", err)
+	}
+	if !info.IsDir() {
+		return "", fmt.Errorf("volume is not a directory: %s", volume)
+	}
+
+	return volumeDir, nil
+}
+
+func isNamedVolume(ctx context.Context, name string) (bool, error) {
+	cli, err := client.NewClientWithOpts(client.FromEnv, client.WithAPIVersionNegotiation())
+	if err != nil {
+		return false, err
+	}
+	defer cli.Close()
+
+	_, err = cli.VolumeInspect(ctx, name)
+	if errdefs.IsNotFound(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("could not inspect volume: %w", err)
+	}
+
+	return true, nil
+}
+
+// stopContainerForVolumeOperation stops the running container if it was requested,
+// the returned function starts it again
+func stopContainerForVolumeOperation(ctx context.Context, volLog *dogger.VolumeOperationLogger,
+	target *common.ContainerIdentifier, stop bool,
+) (func(), error) {
+	noop := func() {}
+	if !stop {
+		return noop, nil
+	}
+
+	container, err := GetContainerByPrefixAndName(ctx, target.GetPrefix(), target.GetName())
+	if err != nil {
+		return noop, fmt.Errorf("container not found: %w", err)
+	}
+	if container == nil {
+		return noop, fmt.Errorf("container not found: %s", target.GetName())
+	}
+	if container.State != "running" {
+		return noop, nil
+	}
+
+	cli, err := client.NewClientWithOpts(client.FromEnv, client.WithAPIVersionNegotiation())
+	if err != nil {
+		return noop, err
+	}
+
+	volLog.Write("Stopping container: " + target.GetName())
+	if err = cli.ContainerStop(ctx, container.ID, nil); err != nil {
+		cli.Close()
+		return noop, fmt.Errorf("could not stop container: %w", err)
+	}
+
+	return func() {
+		defer cli.Close()
+
+		// the request context might be canceled already, the container has to be started anyway
+		restartCtx, cancel := context.WithTimeout(context.Background(), volumeRestartTimeout)
+		defer cancel()
+
+		volLog.Write("Starting container: " + target.GetName())
+		if err := cli.ContainerStart(restartCtx, container.ID, types.ContainerStartOptions{}); err != nil {
+			log.Error().Err(err).Str("prefix", target.GetPrefix()).Str("name", target.GetName()).
+				Msg("Failed to start the container after the volume operation")
+			volLog.Write("Failed to start container: " + err.Error())
+		}
+	}, nil
+}
+
+func createVolumeStagingDir(cfg *config.Configuration, target *common.ContainerIdentifier, operationID string) (string, error) {
+	if !isPathSegment(operationID) {
+		return "", fmt.Errorf("invalid volume operation id: %q", operationID)
+	}
+
+	stagingDir := path.Join(cfg.InternalMountPath, target.GetPrefix(), target.GetName(), volumeStagingDir, operationID)
+	if err := os.MkdirAll(stagingDir, os.ModePerm); err != nil {
+		return "", fmt.Errorf("could not create the staging directory: %w", err)
+	}
+
+	return stagingDir, nil
+}
+
+func removeVolumeStagingDir(stagingDir string) {
+	if err := os.RemoveAll(stagingDir); err != nil {
+		log.Warn().Err(err).Str("path", stagingDir).Msg("Failed to remove the volume staging directory")
+	}
+}
+
+// runRclone copies the staged archive from or to the remote using the import container image
+func runRclone(ctx context.Context, volLog *dogger.VolumeOperationLogger, cfg *config.Configuration,
+	target *common.ContainerIdentifier, operationID string, cmd []string, envs map[string]string,
+) error {
+	cli, err := client.NewClientWithOpts(client.FromEnv, client.WithAPIVersionNegotiation())
+	if err != nil {
+		return err
+	}
+	defer cli.Close()
+
+	// the staging directory is mounted from the host, the same way as the volumes
+	stagingMount := mount.Mount{
+		Type:   mount.TypeBind,
+		Source: path.Join(cfg.DataMountPath, target.GetPrefix(), target.GetName(), volumeStagingDir, operationID),
+		Target: path.Dir(rcloneArchivePath),
+	}
+
+	builder, err := containerbuilder.NewDockerBuilder(ctx).
+		WithClient(cli).
+		WithImage(cfg.ImportContainerImage).
+		WithCmd(cmd).
+		WithName(util.JoinV("-", target.GetPrefix(), target.GetName(), "volume", operationID)).
+		WithEnv(EnvMapToSlice(envs)).
+		WithMountPoints([]mount.Mount{stagingMount}).
+		WithoutConflict().
+		WithLogWriter(volLog).
+		Create()
+	if err != nil {
+		return err
+	}
+
+	exitResult, err := builder.StartWaitUntilExit()
+	if err != nil {
+		return fmt.Errorf("rclone container start failed: %w", err)
+	}
+
+	if err = dockerHelper.DeleteContainerByID(ctx, nil, *builder.GetContainerID()); err != nil {
+		log.Warn().Err(err).Msg("Failed to delete rclone container after completion")
+	}
+
+	if exitResult.StatusCode != 0 {
+		return fmt.Errorf("rclone container exited with code: %v", exitResult.StatusCode)
+	}
+
+	return nil
+}
+
+// progressWriter reports the number of bytes written periodically
+type progressWriter struct {
+	volLog   *dogger.VolumeOperationLogger
+	written  uint64
+	reported uint64
+}
+
+func (w *progressWriter) Write(p []byte) (int, error) {
+	w.written += uint64(len(p))
+	if w.written-w.reported >= volumeProgressInterval {
+		w.reported = w.written
+		w.volLog.WriteProgress(w.written)
+	}
+
+	return len(p), nil
+}
+
+// archiveVolume writes the content of the directory as a gzip compressed tar archive
+func archiveVolume(volumeDir string, archive io.Writer, volLog *dogger.VolumeOperationLogger) error {
+	progress := &progressWriter{volLog: volLog}
+	gzipWriter := gzip.NewWriter(io.MultiWriter(archive, progress))
+	tarWriter := tar.NewWriter(gzipWriter)
+
+	err := filepath.Walk(volumeDir, func(filePath string, info os.FileInfo, walkErr error) error {
+		if walkErr != nil {
+			return walkErr
+		}
+
+		name, err := filepath.Rel(volumeDir, filePath)
+		if err != nil || name == "." {
+			return err
+		}
+
+		link := ""
+		if info.Mode()&os.ModeSymlink != 0 {
+			if link, err = os.Readlink(filePath); err != nil {
+				return err
+			}
+		}
+
+		header, err := tar.FileInfoHeader(info, link)
+		if err != nil {
+			return err
+		}
+		header.Name = filepath.ToSlash(name)
+
+		if err = tarWriter.WriteHeader(header); err != nil {
+			return err
+		}
+
+		if !info.Mode().IsRegular() {
+			return nil
+		}
+
+		file, err := os.Open(filePath)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+
+		_, err = io.Copy(tarWriter, file)
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("could not archive the volume: %w", err)
+	}
+
+	if err = tarWriter.Close(); err != nil {
+		return err
+	}
+	if err = gzipWriter.Close(); err != nil {
+		return err
+	}
+
+	volLog.WriteProgress(progress.written, fmt.Sprintf("Archived %d bytes", progress.written))
+	return nil
+}
+
+// restoreVolume extracts the archive next to the volume first, the content of the volume
+// is only replaced if the whole archive is valid
+func restoreVolume(volumeDir string, archive io.Reader, volLog *dogger.VolumeOperationLogger) error {
+	extractDir, err := os.MkdirTemp(path.Dir(volumeDir), "."+path.Base(volumeDir)+"-restore-")
+	if err != nil {
+		return fmt.Errorf("could not create the restore directory: %w", err)
+	}
+	defer removeVolumeStagingDir(extractDir)
+
+	progress := &progressWriter{volLog: volLog}
+	if err = extractArchive(extractDir, io.TeeReader(archive, progress)); err != nil {
+		return fmt.Errorf("could not extract the archive: %w", err)
+	}
+
+	if err = replaceDirectoryContent(volumeDir, extractDir); err != nil {
+		return fmt.Errorf("could not replace the volume content: %w", err)
+	}
+
+	volLog.WriteProgress(progress.written, fmt.Sprintf("Restored %d bytes", progress.written))
+	return nil
+}
+
+func extractArchive(targetDir string, archive io.Reader) error {
+	gzipReader, err := gzip.NewReader(archive)
+	if err != nil {
+		return err
+	}
+	defer gzipReader.Close()
+
+	tarReader := tar.NewReader(gzipReader)
+	for {
+		header, err := tarReader.Next()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		target, err := archiveEntryPath(targetDir, header.Name)
+		if err != nil {
+			return err
+		}
+		if err = ensureNoSymlink(targetDir, target); err != nil {
+			return err
+		}
+
+		mode := os.FileMode(header.Mode).Perm()
+		switch header.Typeflag {
+		case tar.TypeDir:
+			err = os.MkdirAll(target, mode|0o700)
+		case tar.TypeReg:
+			err = extractFile(target, mode, tarReader)
+		case tar.TypeSymlink:
+			if !symlinkInside(header.Name, header.Linkname) {
+				return fmt.Errorf("symlink points outside of the volume: %s", header.Name)
+			}
+			err = os.Symlink(header.Linkname, target)
+		default:
+			log.Warn().Str("name", header.Name).Msg("Skipping unsupported archive entry")
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		if err = os.Lchown(target, header.Uid, header.Gid); err != nil {
+			log.Warn().Err(err).Str("name", header.Name).Msg("Failed to restore the owner")
+		}
+	}
+}
+
+// archiveEntryPath rejects the entries escaping the target directory
+func archiveEntryPath(targetDir, name string) (string, error) {
+	if !pathInside(name) {
+		return "", fmt.Errorf("invalid archive entry: %s", name)
+	}
+
+	return filepath.Join(targetDir, filepath.FromSlash(path.Clean(name))), nil
+}
+
+// ensureNoSymlink rejects the entries written through a symlink, the links extracted earlier from the same archive
+// could point anywhere once they are chained, only the entries under real directories stay inside of the volume
+func ensureNoSymlink(targetDir, target string) error {
+	rel, err := filepath.Rel(targetDir, target)
+	if err != nil {
+		return err
+	}
+
+	current := targetDir
+	for _, segment := range strings.Split(rel, string(filepath.Separator)) {
+		current = filepath.Join(current, segment)
+
+		info, err := os.Lstat(current)
+		if errors.Is(err, os.ErrNotExist) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		if info.Mode()&os.ModeSymlink != 0 {
+			return fmt.Errorf("archive entry is written through a symlink: %s", filepath.ToSlash(rel))
+		}
+	}
+
+	return nil
+}
+
+// isPathSegment is true for names usable as a single directory
+func isPathSegment(name string) bool {
+	return name != "" && name != "." && name != ".." && !strings.ContainsAny(name, `/\`)
+}
+
+// pathInside is true for relative paths not leaving their root
+func pathInside(name string) bool {
+	cleaned := path.Clean(filepath.ToSlash(name))
+	return !path.IsAbs(cleaned) && cleaned != "." && cleaned != ".." && !strings.HasPrefix(cleaned, "../")
+}
+
+// symlinkInside is true if the link resolves to a path inside of the volume
+func symlinkInside(name, linkname string) bool {
+	return !path.IsAbs(linkname) && pathInside(path.Join(path.Dir(filepath.ToSlash(name)), linkname))
+}
+
+func extractFile(target string, mode os.FileMode, content io.Reader) error {
+	if err := os.MkdirAll(filepath.Dir(target), os.ModePerm); err != nil {
+		return err
+	}
+
+	file, err := os.OpenFile(target, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, mode)
+	if err != nil {
+		return err
+	}
+
+	_, err = io.Copy(file, content)
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	return err
+}
+
+// replaceDirectoryContent keeps the directory itself, it is bind mounted into the container
+func replaceDirectoryContent(targetDir, sourceDir string) error {
+	existing, err := os.ReadDir(targetDir)
+	if err != nil {
+		return err
+	}
+	for _, entry := range existing {
+		if err = os.RemoveAll(filepath.Join(targetDir, entry.Name())); err != nil {
+			return err
+		}
+	}
+
+	restored, err := os.ReadDir(sourceDir)
+	if err != nil {
+		return err
+	}
+	for _, entry := range restored {
+		if err = os.Rename(filepath.Join(sourceDir, entry.Name()), filepath.Join(targetDir, entry.Name())); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
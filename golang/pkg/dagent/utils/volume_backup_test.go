@@ -0,0 +1,146 @@
+//go:build unit
+// +build unit
+
+package utils
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dyrector-io/dyrectorio/golang/internal/dogger"
+	"github.com/dyrector-io/dyrectorio/golang/pkg/dagent/config"
+	"github.com/dyrector-io/dyrectorio/protobuf/go/common"
+)
+
+func testArchive(t *testing.T, headers ...*tar.Header) *bytes.Buffer {
+	archive := &bytes.Buffer{}
+	gzipWriter := gzip.NewWriter(archive)
+	tarWriter := tar.NewWriter(gzipWriter)
+
+	for _, header := range headers {
+		assert.NoError(t, tarWriter.WriteHeader(header))
+		if header.Size > 0 {
+			_, err := tarWriter.Write(bytes.Repeat([]byte("x"), int(header.Size)))
+			assert.NoError(t, err)
+		}
+	}
+
+	assert.NoError(t, tarWriter.Close())
+	assert.NoError(t, gzipWriter.Close())
+	return archive
+}
+
+func TestVolumeArchiveRoundTrip(t *testing.T) {
+	volLog := dogger.NewVolumeOperationLogger("test", nil)
+	source := filepath.Join(t.TempDir(), "data")
+	assert.NoError(t, os.MkdirAll(filepath.Join(source, "nested"), 0o755))
+	assert.NoError(t, os.WriteFile(filepath.Join(source, "nested", "db.sqlite"), []byte("content"), 0o600))
+	assert.NoError(t, os.Symlink("nested/db.sqlite", filepath.Join(source, "current")))
+
+	archive := &bytes.Buffer{}
+	assert.NoError(t, archiveVolume(source, archive, volLog))
+
+	target := filepath.Join(t.TempDir(), "data")
+	assert.NoError(t, os.MkdirAll(target, 0o755))
+	assert.NoError(t, os.WriteFile(filepath.Join(target, "stale"), []byte("old"), 0o644))
+	assert.NoError(t, restoreVolume(target, archive, volLog))
+
+	content, err := os.ReadFile(filepath.Join(target, "current"))
+	assert.NoError(t, err)
+	assert.Equal(t, "content", string(content))
+
+	info, err := os.Stat(filepath.Join(target, "nested", "db.sqlite"))
+	assert.NoError(t, err)
+	assert.Equal(t, os.FileMode(0o600), info.Mode().Perm())
+
+	_, err = os.Stat(filepath.Join(target, "stale"))
+	assert.True(t, os.IsNotExist(err))
+}
+
+func TestRestoreVolumeRejectsTraversal(t *testing.T) {
+	volLog := dogger.NewVolumeOperationLogger("test", nil)
+	root := t.TempDir()
+	target := filepath.Join(root, "data")
+	assert.NoError(t, os.MkdirAll(target, 0o755))
+	assert.NoError(t, os.WriteFile(filepath.Join(target, "kept"), []byte("kept"), 0o644))
+
+	archive := testArchive(t,
+		&tar.Header{Name: "ok", Typeflag: tar.TypeReg, Mode: 0o644, Size: 1},
+		&tar.Header{Name: "../escaped", Typeflag: tar.TypeReg, Mode: 0o644, Size: 1},
+	)
+	assert.Error(t, restoreVolume(target, archive, volLog))
+
+	_, err := os.Stat(filepath.Join(root, "escaped"))
+	assert.True(t, os.IsNotExist(err))
+	// the volume is untouched if the archive is invalid
+	_, err = os.Stat(filepath.Join(target, "kept"))
+	assert.NoError(t, err)
+}
+
+func TestRestoreVolumeRejectsEscapingSymlink(t *testing.T) {
+	volLog := dogger.NewVolumeOperationLogger("test", nil)
+	target := filepath.Join(t.TempDir(), "data")
+	assert.NoError(t, os.MkdirAll(target, 0o755))
+
+	archive := testArchive(t, &tar.Header{Name: "nested/link", Typeflag: tar.TypeSymlink, Linkname: "../../etc"})
+	assert.Error(t, restoreVolume(target, archive, volLog))
+
+	archive = testArchive(t, &tar.Header{Name: "link", Typeflag: tar.TypeSymlink, Linkname: "/etc"})
+	assert.Error(t, restoreVolume(target, archive, volLog))
+}
+
+func TestRestoreVolumeRejectsWritingThroughSymlinks(t *testing.T) {
+	volLog := dogger.NewVolumeOperationLogger("test", nil)
+	root := t.TempDir()
+	target := filepath.Join(root, "data")
+	assert.NoError(t, os.MkdirAll(target, 0o755))
+
+	// every link resolves inside of the volume on its own, chained they point to the parent of the volume
+	archive := testArchive(t,
+		&tar.Header{Name: "x/y", Typeflag: tar.TypeDir, Mode: 0o755},
+		&tar.Header{Name: "x/y/a", Typeflag: tar.TypeSymlink, Linkname: ".."},
+		&tar.Header{Name: "x/y/a/l", Typeflag: tar.TypeSymlink, Linkname: "../.."},
+		&tar.Header{Name: "x/y/a/l/evil", Typeflag: tar.TypeReg, Mode: 0o644, Size: 1},
+	)
+	assert.Error(t, restoreVolume(target, archive, volLog))
+
+	_, err := os.Lstat(filepath.Join(root, "evil"))
+	assert.True(t, os.IsNotExist(err))
+	_, err = os.Lstat(filepath.Join(root, "l"))
+	assert.True(t, os.IsNotExist(err))
+
+	// a file entry replacing an extracted link is not written through it
+	archive = testArchive(t,
+		&tar.Header{Name: "link", Typeflag: tar.TypeSymlink, Linkname: "nested/file"},
+		&tar.Header{Name: "link", Typeflag: tar.TypeReg, Mode: 0o644, Size: 1},
+	)
+	assert.Error(t, restoreVolume(target, archive, volLog))
+}
+
+func TestIsPathSegment(t *testing.T) {
+	assert.True(t, isPathSegment("data"))
+	assert.False(t, isPathSegment(""))
+	assert.False(t, isPathSegment(".."))
+	assert.False(t, isPathSegment("data/../.."))
+}
+
+func TestVolumeDirectory(t *testing.T) {
+	cfg := &config.Configuration{InternalMountPath: t.TempDir()}
+	target := &common.ContainerIdentifier{Prefix: "prefix", Name: "api"}
+	assert.NoError(t, os.MkdirAll(filepath.Join(cfg.InternalMountPath, "prefix", "api", "data"), 0o755))
+
+	volumeDir, err := volumeDirectory(context.Background(), cfg, target, "data")
+	assert.NoError(t, err)
+	assert.Equal(t, filepath.Join(cfg.InternalMountPath, "prefix", "api", "data"), volumeDir)
+
+	// eg. a tmp volume
+	_, err = volumeDirectory(context.Background(), cfg, target, "cache")
+	assert.ErrorContains(t, err, "tmp and mem volumes can not be backed up")
+}
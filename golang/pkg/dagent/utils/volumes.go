@@ -0,0 +1,101 @@
+package utils
+
+import (
+	"strings"
+
+	"github.com/docker/docker/api/types/mount"
+	"github.com/rs/zerolog/log"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	v1 "github.com/dyrector-io/dyrectorio/golang/api/v1"
+	"github.com/dyrector-io/dyrectorio/golang/internal/util"
+	"github.com/dyrector-io/dyrectorio/golang/pkg/dagent/config"
+)
+
+// label of the named volumes, the name of the container using it
+const LabelContainerName = "container.name"
+
+// volumesToDocker maps the volumes by their type: tmp and mem are tmpfs mounts, RO is a read-only bind mount,
+// the rest are bind mounts or named volumes if they are enabled
+func volumesToDocker(volumes []v1.Volume, prefix, containerName string, cfg *config.Configuration) []mount.Mount {
+	mounts := []mount.Mount{}
+
+	for i := range volumes {
+		volume := &volumes[i]
+		if volume.Name == "" || volume.Path == "" {
+			log.Warn().Str("name", volume.Name).Str("path", volume.Path).Msg("Empty values in volumes")
+			continue
+		}
+
+		switch {
+		case isVolumeType(volume, v1.MemoryVolumeType, v1.EmptyDirVolumeType):
+			// docker has no disk backed tmpfs, temporary volumes live in memory too
+			mounts = append(mounts, tmpfsMount(volume, cfg))
+		case isVolumeType(volume, v1.ReadOnlyVolumeType):
+			mounts = append(mounts, bindMounts(volume, prefix, containerName, true, cfg)...)
+		default:
+			if cfg.NamedVolumes {
+				mounts = append(mounts, namedVolumeMount(volume, prefix, containerName, cfg))
+			} else {
+				mounts = append(mounts, bindMounts(volume, prefix, containerName, false, cfg)...)
+			}
+		}
+	}
+
+	return mounts
+}
+
+// isVolumeType compares the types case-insensitively, the gRPC enum names are upper case
+func isVolumeType(volume *v1.Volume, volumeTypes ...v1.VolumeType) bool {
+	for _, volumeType := range volumeTypes {
+		if strings.EqualFold(volume.Type, string(volumeType)) {
+			return true
+		}
+	}
+	return false
+}
+
+func tmpfsMount(volume *v1.Volume, cfg *config.Configuration) mount.Mount {
+	size, err := resource.ParseQuantity(util.Fallback(volume.Size, cfg.DefaultVolumeSize))
+	if err != nil {
+		log.Warn().Str("volumeName", volume.Name).Str("inputVolumeSize", volume.Size).
+			Msg("Input volume size is invalid, using defaults")
+		size = resource.MustParse(cfg.DefaultVolumeSize)
+	}
+
+	return mount.Mount{
+		Type:         mount.TypeTmpfs,
+		Target:       volume.Path,
+		TmpfsOptions: &mount.TmpfsOptions{SizeBytes: size.Value()},
+	}
+}
+
+// bindMounts are the legacy host directories of the volumes
+func bindMounts(volume *v1.Volume, prefix, containerName string, readOnly bool, cfg *config.Configuration) []mount.Mount {
+	mounts := mountStrToDocker([]string{volume.Name + "|" + volume.Path}, prefix, containerName, cfg)
+	for i := range mounts {
+		mounts[i].ReadOnly = readOnly
+	}
+	return mounts
+}
+
+// namedVolumeMount is created by Docker on the first use with the configured driver
+func namedVolumeMount(volume *v1.Volume, prefix, containerName string, cfg *config.Configuration) mount.Mount {
+	volumeOptions := &mount.VolumeOptions{
+		Labels: map[string]string{
+			LabelDyrectorioOrg + LabelContainerPrefix: prefix,
+			LabelDyrectorioOrg + LabelContainerName:   containerName,
+		},
+	}
+
+	if cfg.VolumeDriver != "" {
+		volumeOptions.DriverConfig = &mount.Driver{Name: cfg.VolumeDriver, Options: cfg.VolumeDriverOptions}
+	}
+
+	return mount.Mount{
+		Type:          mount.TypeVolume,
+		Source:        util.JoinV("-", prefix, containerName, volume.Name),
+		Target:        volume.Path,
+		VolumeOptions: volumeOptions,
+	}
+}
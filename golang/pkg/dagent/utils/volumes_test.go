@@ -0,0 +1,99 @@
+//go:build unit
+// +build unit
+
+package utils
+
+import (
+	"path"
+	"testing"
+
+	"github.com/docker/docker/api/types/mount"
+	"github.com/stretchr/testify/assert"
+
+	v1 "github.com/dyrector-io/dyrectorio/golang/api/v1"
+	commonConfig "github.com/dyrector-io/dyrectorio/golang/internal/config"
+	"github.com/dyrector-io/dyrectorio/golang/pkg/dagent/config"
+)
+
+func testVolumeConfig(t *testing.T) *config.Configuration {
+	return &config.Configuration{
+		CommonConfiguration: commonConfig.CommonConfiguration{DefaultVolumeSize: "1G"},
+		DataMountPath:       "/srv/dagent",
+		InternalMountPath:   t.TempDir(),
+	}
+}
+
+func TestVolumesToDockerTmpfs(t *testing.T) {
+	mounts := volumesToDocker([]v1.Volume{
+		{Name: "cache", Path: "/cache", Type: "mem", Size: "64Mi"},
+		{Name: "tmp", Path: "/tmp", Type: "TMP"},
+	}, "prefix", "name", testVolumeConfig(t))
+
+	assert.Equal(t, []mount.Mount{
+		{Type: mount.TypeTmpfs, Target: "/cache", TmpfsOptions: &mount.TmpfsOptions{SizeBytes: 64 * 1024 * 1024}},
+		{Type: mount.TypeTmpfs, Target: "/tmp", TmpfsOptions: &mount.TmpfsOptions{SizeBytes: 1_000_000_000}},
+	}, mounts)
+}
+
+func TestVolumesToDockerBind(t *testing.T) {
+	mounts := volumesToDocker([]v1.Volume{
+		{Name: "config", Path: "/config", Type: "RO"},
+		{Name: "data", Path: "/data", Type: "RWO"},
+	}, "prefix", "name", testVolumeConfig(t))
+
+	assert.Equal(t, []mount.Mount{
+		{Type: mount.TypeBind, Source: path.Join("/srv/dagent", "prefix", "name", "config"), Target: "/config", ReadOnly: true},
+		{Type: mount.TypeBind, Source: path.Join("/srv/dagent", "prefix", "name", "data"), Target: "/data"},
+	}, mounts)
+}
+
+func TestVolumesToDockerNamedVolumes(t *testing.T) {
+	cfg := testVolumeConfig(t)
+	cfg.NamedVolumes = true
+	cfg.VolumeDriver = "local"
+	cfg.VolumeDriverOptions = map[string]string{"type": "nfs", "device": ":/export"}
+
+	mounts := volumesToDocker([]v1.Volume{{Name: "data", Path: "/data", Type: "RWX"}}, "prefix", "name", cfg)
+
+	assert.Equal(t, []mount.Mount{{
+		Type:   mount.TypeVolume,
+		Source: "prefix-name-data",
+		Target: "/data",
+		VolumeOptions: &mount.VolumeOptions{
+			Labels: map[string]string{
+				"org.dyrectorio.container.prefix": "prefix",
+				"org.dyrectorio.container.name":   "name",
+			},
+			DriverConfig: &mount.Driver{Name: "local", Options: cfg.VolumeDriverOptions},
+		},
+	}}, mounts)
+}
+
+func TestVolumesToDockerReadOnlyCaseInsensitive(t *testing.T) {
+	mounts := volumesToDocker([]v1.Volume{{Name: "config", Path: "/config", Type: "ro"}}, "prefix", "name", testVolumeConfig(t))
+
+	assert.Len(t, mounts, 1)
+	assert.True(t, mounts[0].ReadOnly)
+}
+
+func TestRuntimeConfigWithNamedVolumes(t *testing.T) {
+	cfg := testVolumeConfig(t)
+	cfg.NamedVolumes = true
+
+	mounts := volumesToDocker([]v1.Volume{
+		{Name: "config", Path: "/app/config", Type: "RWO"},
+		{Name: "data", Path: "/data", Type: "RWO"},
+	}, "prefix", "name", cfg)
+
+	mounts, err := createRuntimeConfigFileOnHost(mounts, "name", "prefix", `{"debug": true}`, cfg)
+	assert.NoError(t, err)
+
+	// the named config volume would never see the file written on the host
+	assert.Equal(t, mount.Mount{
+		Type:   mount.TypeBind,
+		Source: path.Join("/srv/dagent", "prefix", "name", "config"),
+		Target: "/app/config",
+	}, mounts[0])
+	assert.Equal(t, mount.TypeVolume, mounts[1].Type)
+	assert.FileExists(t, path.Join(cfg.InternalMountPath, "prefix", "name", "config", "appsettings.json"))
+}
@@ -0,0 +1,3 @@
+package image
+
+var MatchRepoDigestForTest = matchRepoDigest
@@ -12,11 +12,16 @@
 	"github.com/docker/docker/api/types"
 	"github.com/docker/docker/api/types/filters"
 	"github.com/docker/docker/client"
+	"github.com/opencontainers/go-digest"
 	"github.com/rs/zerolog/log"
 
+	"github.com/dyrector-io/dyrectorio/golang/internal/metrics"
 	"github.com/dyrector-io/dyrectorio/protobuf/go/agent"
 )
 
+// ErrNoRepoDigest is returned for the images which were never pushed to or pulled from their repository
+var ErrNoRepoDigest = errors.New("image has no repository digest")
+
 // PullResponse is not explicit
 type PullResponse struct {
 	ID             string `json:"id"`
@@ -114,6 +119,7 @@ func Pull(ctx context.Context, logger io.StringWriter, expandedImageName, authCr
 		}
 	}
 
+	pullStarted := time.Now()
 	reader, err := cli.ImagePull(ctx, expandedImageName, types.ImagePullOptions{RegistryAuth: authCreds})
 	if err != nil {
 		return err
@@ -122,6 +128,8 @@ func Pull(ctx context.Context, logger io.StringWriter, expandedImageName, authCr
 
 	d := json.NewDecoder(reader)
 
+	// downloaded bytes of the layers, the progress of a layer is reported as a running total
+	downloaded := map[string]int64{}
 	var lastLog time.Time
 	for {
 		pullResult := PullResponse{}
@@ -134,6 +142,11 @@ func Pull(ctx context.Context, logger io.StringWriter, expandedImageName, authCr
 			break
 		}
 
+		if pullResult.Status == "Downloading" && pullResult.ProgressDetail.Current > downloaded[pullResult.ID] {
+			metrics.ImagePullBytes.Add(float64(pullResult.ProgressDetail.Current - downloaded[pullResult.ID]))
+			downloaded[pullResult.ID] = pullResult.ProgressDetail.Current
+		}
+
 		if logger != nil && time.Since(lastLog) > time.Second {
 			var logErr error
 			if pullResult.ProgressDetail.Current != 0 && pullResult.ProgressDetail.Total != 0 {
@@ -154,6 +167,10 @@ func Pull(ctx context.Context, logger io.StringWriter, expandedImageName, authCr
 		}
 	}
 
+	if err == nil {
+		metrics.ObserveDeployStep(metrics.StepPull, pullStarted)
+	}
+
 	return err
 }
 
@@ -211,3 +228,96 @@ func SplitImageName(expandedImageName string) (name, tag string, err error) {
 
 	return tagged.Name(), tagged.Tag(), nil
 }
+
+// SplitImageReference splits a tagged and/or digested image reference, a reference without either has the latest tag
+func SplitImageReference(image string) (name, tag, imageDigest string, err error) {
+	ref, err := reference.ParseAnyReference(image)
+	if err != nil {
+		return "", "", "", err
+	}
+
+	// image ids are digests without a name
+	named, ok := ref.(reference.Named)
+	if !ok {
+		return "", "", "", errors.New("image reference has no name")
+	}
+
+	if reference.IsNameOnly(named) {
+		named = reference.TagNameOnly(named)
+	}
+
+	if tagged, ok := named.(reference.Tagged); ok {
+		tag = tagged.Tag()
+	}
+	if canonical, ok := named.(reference.Canonical); ok {
+		imageDigest = canonical.Digest().String()
+	}
+
+	return named.Name(), tag, imageDigest, nil
+}
+
+// RepoDigest returns the digest (sha256:...) of the local image in the repository of the image name
+func RepoDigest(ctx context.Context, expandedImageName string) (string, error) {
+	named, err := reference.ParseNormalizedNamed(expandedImageName)
+	if err != nil {
+		return "", err
+	}
+
+	if canonical, ok := named.(reference.Canonical); ok {
+		return canonical.Digest().String(), nil
+	}
+
+	cli, err := client.NewClientWithOpts(client.FromEnv, client.WithAPIVersionNegotiation())
+	if err != nil {
+		return "", err
+	}
+
+	defer cli.Close()
+
+	inspect, _, err := cli.ImageInspectWithRaw(ctx, expandedImageName)
+	if err != nil {
+		return "", err
+	}
+
+	return matchRepoDigest(inspect.RepoDigests, named.Name())
+}
+
+// matchRepoDigest finds the digest of the repository, an image has a digest for every repository it was pulled from
+func matchRepoDigest(repoDigests []string, name string) (string, error) {
+	for _, repoDigest := range repoDigests {
+		ref, err := reference.ParseNormalizedNamed(repoDigest)
+		if err != nil {
+			continue
+		}
+
+		if canonical, ok := ref.(reference.Canonical); ok && ref.Name() == name {
+			return canonical.Digest().String(), nil
+		}
+	}
+
+	return "", ErrNoRepoDigest
+}
+
+// PinDigest refers to the image by the digest, the tag is kept for information only
+func PinDigest(expandedImageName, imageDigest string) (string, error) {
+	named, err := reference.ParseNormalizedNamed(expandedImageName)
+	if err != nil {
+		return "", err
+	}
+
+	if _, ok := named.(reference.Canonical); ok {
+		return named.String(), nil
+	}
+
+	parsedDigest, err := digest.Parse(imageDigest)
+	if err != nil {
+		return "", err
+	}
+
+	pinned, err := reference.WithDigest(named, parsedDigest)
+	if err != nil {
+		return "", err
+	}
+
+	return pinned.String(), nil
+}
@@ -165,3 +165,61 @@ func TestSplitImageName(t *testing.T) {
 	name, tag, err = imageHelper.SplitImageName("my-reg.com/test/nginx")
 	assert.Error(t, err)
 }
+
+const testDigest = "sha256:4c0fdaa8b6341bfdeca5f18f7837462c80cff90527ee35ef185571e1c327beac"
+
+func TestSplitImageReference(t *testing.T) {
+	name, tag, digest, err := imageHelper.SplitImageReference("nginx")
+	assert.NoError(t, err)
+	assert.Equal(t, "docker.io/library/nginx", name)
+	assert.Equal(t, "latest", tag)
+	assert.Empty(t, digest)
+
+	name, tag, digest, err = imageHelper.SplitImageReference("localhost:5000/test/nginx:tag-1")
+	assert.NoError(t, err)
+	assert.Equal(t, "localhost:5000/test/nginx", name)
+	assert.Equal(t, "tag-1", tag)
+	assert.Empty(t, digest)
+
+	name, tag, digest, err = imageHelper.SplitImageReference("my-reg.com/test/nginx:tag-2@" + testDigest)
+	assert.NoError(t, err)
+	assert.Equal(t, "my-reg.com/test/nginx", name)
+	assert.Equal(t, "tag-2", tag)
+	assert.Equal(t, testDigest, digest)
+
+	name, tag, digest, err = imageHelper.SplitImageReference("my-reg.com/test/nginx@" + testDigest)
+	assert.NoError(t, err)
+	assert.Equal(t, "my-reg.com/test/nginx", name)
+	assert.Empty(t, tag)
+	assert.Equal(t, testDigest, digest)
+
+	_, _, _, err = imageHelper.SplitImageReference(testDigest)
+	assert.Error(t, err)
+}
+
+func TestMatchRepoDigest(t *testing.T) {
+	repoDigests := []string{
+		"my-reg.com/library/nginx@sha256:0000000000000000000000000000000000000000000000000000000000000000",
+		"nginx@" + testDigest,
+	}
+
+	digest, err := imageHelper.MatchRepoDigestForTest(repoDigests, "docker.io/library/nginx")
+	assert.NoError(t, err)
+	assert.Equal(t, testDigest, digest)
+
+	_, err = imageHelper.MatchRepoDigestForTest(repoDigests, "my-reg.com/test/nginx")
+	assert.ErrorIs(t, err, imageHelper.ErrNoRepoDigest)
+}
+
+func TestPinDigest(t *testing.T) {
+	pinned, err := imageHelper.PinDigest("docker.io/library/nginx:tag-1", testDigest)
+	assert.NoError(t, err)
+	assert.Equal(t, "docker.io/library/nginx:tag-1@"+testDigest, pinned)
+
+	pinned, err = imageHelper.PinDigest("localhost:5000/nginx:tag-1@"+testDigest, "sha256:other")
+	assert.NoError(t, err)
+	assert.Equal(t, "localhost:5000/nginx:tag-1@"+testDigest, pinned)
+
+	_, err = imageHelper.PinDigest("docker.io/library/nginx:tag-1", "not-a-digest")
+	assert.Error(t, err)
+}
@@ -36,6 +36,34 @@ type AgentClient interface {
 	AbortUpdate(ctx context.Context, in *AgentAbortUpdate, opts ...grpc.CallOption) (*common.Empty, error)
 	DeleteContainers(ctx context.Context, in *common.DeleteContainersRequest, opts ...grpc.CallOption) (*common.Empty, error)
 	ContainerLog(ctx context.Context, opts ...grpc.CallOption) (Agent_ContainerLogClient, error)
+	//*
+	// Reports the outcome of an AgentCommand, correlated by its commandId.
+	// Sent only for commands with a non-empty commandId.
+	CommandResult(ctx context.Context, in *CommandResultRequest, opts ...grpc.CallOption) (*common.Empty, error)
+	//*
+	// Volume backup and restore, correlated by the dyo-volume-operation-id
+	// metadata. The archive is uploaded by VolumeBackupArchive and downloaded
+	// by VolumeRestoreArchive, unless an rclone remote is used.
+	VolumeOperationStatus(ctx context.Context, opts ...grpc.CallOption) (Agent_VolumeOperationStatusClient, error)
+	VolumeBackupArchive(ctx context.Context, opts ...grpc.CallOption) (Agent_VolumeBackupArchiveClient, error)
+	VolumeRestoreArchive(ctx context.Context, in *VolumeArchiveRequest, opts ...grpc.CallOption) (Agent_VolumeRestoreArchiveClient, error)
+	//*
+	// Interactive exec session, correlated by the dyo-container-exec-id
+	// metadata. The agent sends the output, crux sends the input and the
+	// terminal size.
+	ContainerExec(ctx context.Context, opts ...grpc.CallOption) (Agent_ContainerExecClient, error)
+	//*
+	// Resource usage samples, correlated by the dyo-container-prefix and
+	// dyo-container-name metadata like ContainerLog, closed by the server.
+	ContainerStats(ctx context.Context, opts ...grpc.CallOption) (Agent_ContainerStatsClient, error)
+	//*
+	// Container file browser, the downloaded and uploaded tar archives are
+	// correlated by the dyo-container-file-id metadata.
+	ContainerFileList(ctx context.Context, in *ContainerFileListResponse, opts ...grpc.CallOption) (*common.Empty, error)
+	ContainerFileDownload(ctx context.Context, opts ...grpc.CallOption) (Agent_ContainerFileDownloadClient, error)
+	ContainerFileUpload(ctx context.Context, in *ContainerFileRequest, opts ...grpc.CallOption) (Agent_ContainerFileUploadClient, error)
+	ImagePruneResult(ctx context.Context, in *ImagePruneResponse, opts ...grpc.CallOption) (*common.Empty, error)
+	DiskUsage(ctx context.Context, in *DiskUsageResponse, opts ...grpc.CallOption) (*common.Empty, error)
 }
 
 type agentClient struct {
@@ -207,6 +235,273 @@ func (x *agentContainerLogClient) CloseAndRecv() (*common.Empty, error) {
 	return m, nil
 }
 
+func (c *agentClient) CommandResult(ctx context.Context, in *CommandResultRequest, opts ...grpc.CallOption) (*common.Empty, error) {
+	out := new(common.Empty)
+	err := c.cc.Invoke(ctx, "/agent.Agent/CommandResult", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentClient) VolumeOperationStatus(ctx context.Context, opts ...grpc.CallOption) (Agent_VolumeOperationStatusClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Agent_ServiceDesc.Streams[4], "/agent.Agent/VolumeOperationStatus", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &agentVolumeOperationStatusClient{stream}
+	return x, nil
+}
+
+type Agent_VolumeOperationStatusClient interface {
+	Send(*VolumeOperationStatusMessage) error
+	CloseAndRecv() (*common.Empty, error)
+	grpc.ClientStream
+}
+
+type agentVolumeOperationStatusClient struct {
+	grpc.ClientStream
+}
+
+func (x *agentVolumeOperationStatusClient) Send(m *VolumeOperationStatusMessage) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *agentVolumeOperationStatusClient) CloseAndRecv() (*common.Empty, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(common.Empty)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *agentClient) VolumeBackupArchive(ctx context.Context, opts ...grpc.CallOption) (Agent_VolumeBackupArchiveClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Agent_ServiceDesc.Streams[5], "/agent.Agent/VolumeBackupArchive", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &agentVolumeBackupArchiveClient{stream}
+	return x, nil
+}
+
+type Agent_VolumeBackupArchiveClient interface {
+	Send(*VolumeArchiveChunk) error
+	CloseAndRecv() (*common.Empty, error)
+	grpc.ClientStream
+}
+
+type agentVolumeBackupArchiveClient struct {
+	grpc.ClientStream
+}
+
+func (x *agentVolumeBackupArchiveClient) Send(m *VolumeArchiveChunk) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *agentVolumeBackupArchiveClient) CloseAndRecv() (*common.Empty, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(common.Empty)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *agentClient) VolumeRestoreArchive(ctx context.Context, in *VolumeArchiveRequest, opts ...grpc.CallOption) (Agent_VolumeRestoreArchiveClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Agent_ServiceDesc.Streams[6], "/agent.Agent/VolumeRestoreArchive", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &agentVolumeRestoreArchiveClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Agent_VolumeRestoreArchiveClient interface {
+	Recv() (*VolumeArchiveChunk, error)
+	grpc.ClientStream
+}
+
+type agentVolumeRestoreArchiveClient struct {
+	grpc.ClientStream
+}
+
+func (x *agentVolumeRestoreArchiveClient) Recv() (*VolumeArchiveChunk, error) {
+	m := new(VolumeArchiveChunk)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *agentClient) ContainerExec(ctx context.Context, opts ...grpc.CallOption) (Agent_ContainerExecClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Agent_ServiceDesc.Streams[7], "/agent.Agent/ContainerExec", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &agentContainerExecClient{stream}
+	return x, nil
+}
+
+type Agent_ContainerExecClient interface {
+	Send(*ContainerExecOutput) error
+	Recv() (*ContainerExecInput, error)
+	grpc.ClientStream
+}
+
+type agentContainerExecClient struct {
+	grpc.ClientStream
+}
+
+func (x *agentContainerExecClient) Send(m *ContainerExecOutput) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *agentContainerExecClient) Recv() (*ContainerExecInput, error) {
+	m := new(ContainerExecInput)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *agentClient) ContainerStats(ctx context.Context, opts ...grpc.CallOption) (Agent_ContainerStatsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Agent_ServiceDesc.Streams[8], "/agent.Agent/ContainerStats", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &agentContainerStatsClient{stream}
+	return x, nil
+}
+
+type Agent_ContainerStatsClient interface {
+	Send(*ContainerStatsMessage) error
+	CloseAndRecv() (*common.Empty, error)
+	grpc.ClientStream
+}
+
+type agentContainerStatsClient struct {
+	grpc.ClientStream
+}
+
+func (x *agentContainerStatsClient) Send(m *ContainerStatsMessage) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *agentContainerStatsClient) CloseAndRecv() (*common.Empty, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(common.Empty)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *agentClient) ContainerFileList(ctx context.Context, in *ContainerFileListResponse, opts ...grpc.CallOption) (*common.Empty, error) {
+	out := new(common.Empty)
+	err := c.cc.Invoke(ctx, "/agent.Agent/ContainerFileList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentClient) ContainerFileDownload(ctx context.Context, opts ...grpc.CallOption) (Agent_ContainerFileDownloadClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Agent_ServiceDesc.Streams[9], "/agent.Agent/ContainerFileDownload", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &agentContainerFileDownloadClient{stream}
+	return x, nil
+}
+
+type Agent_ContainerFileDownloadClient interface {
+	Send(*ContainerFileChunk) error
+	CloseAndRecv() (*common.Empty, error)
+	grpc.ClientStream
+}
+
+type agentContainerFileDownloadClient struct {
+	grpc.ClientStream
+}
+
+func (x *agentContainerFileDownloadClient) Send(m *ContainerFileChunk) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *agentContainerFileDownloadClient) CloseAndRecv() (*common.Empty, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(common.Empty)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *agentClient) ContainerFileUpload(ctx context.Context, in *ContainerFileRequest, opts ...grpc.CallOption) (Agent_ContainerFileUploadClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Agent_ServiceDesc.Streams[10], "/agent.Agent/ContainerFileUpload", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &agentContainerFileUploadClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Agent_ContainerFileUploadClient interface {
+	Recv() (*ContainerFileChunk, error)
+	grpc.ClientStream
+}
+
+type agentContainerFileUploadClient struct {
+	grpc.ClientStream
+}
+
+func (x *agentContainerFileUploadClient) Recv() (*ContainerFileChunk, error) {
+	m := new(ContainerFileChunk)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *agentClient) ImagePruneResult(ctx context.Context, in *ImagePruneResponse, opts ...grpc.CallOption) (*common.Empty, error) {
+	out := new(common.Empty)
+	err := c.cc.Invoke(ctx, "/agent.Agent/ImagePruneResult", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentClient) DiskUsage(ctx context.Context, in *DiskUsageResponse, opts ...grpc.CallOption) (*common.Empty, error) {
+	out := new(common.Empty)
+	err := c.cc.Invoke(ctx, "/agent.Agent/DiskUsage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AgentServer is the server API for Agent service.
 // All implementations must embed UnimplementedAgentServer
 // for forward compatibility
@@ -224,6 +519,34 @@ type AgentServer interface {
 	AbortUpdate(context.Context, *AgentAbortUpdate) (*common.Empty, error)
 	DeleteContainers(context.Context, *common.DeleteContainersRequest) (*common.Empty, error)
 	ContainerLog(Agent_ContainerLogServer) error
+	//*
+	// Reports the outcome of an AgentCommand, correlated by its commandId.
+	// Sent only for commands with a non-empty commandId.
+	CommandResult(context.Context, *CommandResultRequest) (*common.Empty, error)
+	//*
+	// Volume backup and restore, correlated by the dyo-volume-operation-id
+	// metadata. The archive is uploaded by VolumeBackupArchive and downloaded
+	// by VolumeRestoreArchive, unless an rclone remote is used.
+	VolumeOperationStatus(Agent_VolumeOperationStatusServer) error
+	VolumeBackupArchive(Agent_VolumeBackupArchiveServer) error
+	VolumeRestoreArchive(*VolumeArchiveRequest, Agent_VolumeRestoreArchiveServer) error
+	//*
+	// Interactive exec session, correlated by the dyo-container-exec-id
+	// metadata. The agent sends the output, crux sends the input and the
+	// terminal size.
+	ContainerExec(Agent_ContainerExecServer) error
+	//*
+	// Resource usage samples, correlated by the dyo-container-prefix and
+	// dyo-container-name metadata like ContainerLog, closed by the server.
+	ContainerStats(Agent_ContainerStatsServer) error
+	//*
+	// Container file browser, the downloaded and uploaded tar archives are
+	// correlated by the dyo-container-file-id metadata.
+	ContainerFileList(context.Context, *ContainerFileListResponse) (*common.Empty, error)
+	ContainerFileDownload(Agent_ContainerFileDownloadServer) error
+	ContainerFileUpload(*ContainerFileRequest, Agent_ContainerFileUploadServer) error
+	ImagePruneResult(context.Context, *ImagePruneResponse) (*common.Empty, error)
+	DiskUsage(context.Context, *DiskUsageResponse) (*common.Empty, error)
 	mustEmbedUnimplementedAgentServer()
 }
 
@@ -252,6 +575,39 @@ func (UnimplementedAgentServer) DeleteContainers(context.Context, *common.Delete
 func (UnimplementedAgentServer) ContainerLog(Agent_ContainerLogServer) error {
 	return status.Errorf(codes.Unimplemented, "method ContainerLog not implemented")
 }
+func (UnimplementedAgentServer) CommandResult(context.Context, *CommandResultRequest) (*common.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CommandResult not implemented")
+}
+func (UnimplementedAgentServer) VolumeOperationStatus(Agent_VolumeOperationStatusServer) error {
+	return status.Errorf(codes.Unimplemented, "method VolumeOperationStatus not implemented")
+}
+func (UnimplementedAgentServer) VolumeBackupArchive(Agent_VolumeBackupArchiveServer) error {
+	return status.Errorf(codes.Unimplemented, "method VolumeBackupArchive not implemented")
+}
+func (UnimplementedAgentServer) VolumeRestoreArchive(*VolumeArchiveRequest, Agent_VolumeRestoreArchiveServer) error {
+	return status.Errorf(codes.Unimplemented, "method VolumeRestoreArchive not implemented")
+}
+func (UnimplementedAgentServer) ContainerExec(Agent_ContainerExecServer) error {
+	return status.Errorf(codes.Unimplemented, "method ContainerExec not implemented")
+}
+func (UnimplementedAgentServer) ContainerStats(Agent_ContainerStatsServer) error {
+	return status.Errorf(codes.Unimplemented, "method ContainerStats not implemented")
+}
+func (UnimplementedAgentServer) ContainerFileList(context.Context, *ContainerFileListResponse) (*common.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ContainerFileList not implemented")
+}
+func (UnimplementedAgentServer) ContainerFileDownload(Agent_ContainerFileDownloadServer) error {
+	return status.Errorf(codes.Unimplemented, "method ContainerFileDownload not implemented")
+}
+func (UnimplementedAgentServer) ContainerFileUpload(*ContainerFileRequest, Agent_ContainerFileUploadServer) error {
+	return status.Errorf(codes.Unimplemented, "method ContainerFileUpload not implemented")
+}
+func (UnimplementedAgentServer) ImagePruneResult(context.Context, *ImagePruneResponse) (*common.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImagePruneResult not implemented")
+}
+func (UnimplementedAgentServer) DiskUsage(context.Context, *DiskUsageResponse) (*common.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DiskUsage not implemented")
+}
 func (UnimplementedAgentServer) mustEmbedUnimplementedAgentServer() {}
 
 // UnsafeAgentServer may be embedded to opt out of forward compatibility for this service.
@@ -418,6 +774,250 @@ func (x *agentContainerLogServer) Recv() (*common.ContainerLogMessage, error) {
 	return m, nil
 }
 
+func _Agent_CommandResult_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CommandResultRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentServer).CommandResult(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/agent.Agent/CommandResult",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServer).CommandResult(ctx, req.(*CommandResultRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Agent_VolumeOperationStatus_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(AgentServer).VolumeOperationStatus(&agentVolumeOperationStatusServer{stream})
+}
+
+type Agent_VolumeOperationStatusServer interface {
+	SendAndClose(*common.Empty) error
+	Recv() (*VolumeOperationStatusMessage, error)
+	grpc.ServerStream
+}
+
+type agentVolumeOperationStatusServer struct {
+	grpc.ServerStream
+}
+
+func (x *agentVolumeOperationStatusServer) SendAndClose(m *common.Empty) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *agentVolumeOperationStatusServer) Recv() (*VolumeOperationStatusMessage, error) {
+	m := new(VolumeOperationStatusMessage)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _Agent_VolumeBackupArchive_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(AgentServer).VolumeBackupArchive(&agentVolumeBackupArchiveServer{stream})
+}
+
+type Agent_VolumeBackupArchiveServer interface {
+	SendAndClose(*common.Empty) error
+	Recv() (*VolumeArchiveChunk, error)
+	grpc.ServerStream
+}
+
+type agentVolumeBackupArchiveServer struct {
+	grpc.ServerStream
+}
+
+func (x *agentVolumeBackupArchiveServer) SendAndClose(m *common.Empty) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *agentVolumeBackupArchiveServer) Recv() (*VolumeArchiveChunk, error) {
+	m := new(VolumeArchiveChunk)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _Agent_VolumeRestoreArchive_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(VolumeArchiveRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(AgentServer).VolumeRestoreArchive(m, &agentVolumeRestoreArchiveServer{stream})
+}
+
+type Agent_VolumeRestoreArchiveServer interface {
+	Send(*VolumeArchiveChunk) error
+	grpc.ServerStream
+}
+
+type agentVolumeRestoreArchiveServer struct {
+	grpc.ServerStream
+}
+
+func (x *agentVolumeRestoreArchiveServer) Send(m *VolumeArchiveChunk) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Agent_ContainerExec_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(AgentServer).ContainerExec(&agentContainerExecServer{stream})
+}
+
+type Agent_ContainerExecServer interface {
+	Send(*ContainerExecInput) error
+	Recv() (*ContainerExecOutput, error)
+	grpc.ServerStream
+}
+
+type agentContainerExecServer struct {
+	grpc.ServerStream
+}
+
+func (x *agentContainerExecServer) Send(m *ContainerExecInput) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *agentContainerExecServer) Recv() (*ContainerExecOutput, error) {
+	m := new(ContainerExecOutput)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _Agent_ContainerStats_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(AgentServer).ContainerStats(&agentContainerStatsServer{stream})
+}
+
+type Agent_ContainerStatsServer interface {
+	SendAndClose(*common.Empty) error
+	Recv() (*ContainerStatsMessage, error)
+	grpc.ServerStream
+}
+
+type agentContainerStatsServer struct {
+	grpc.ServerStream
+}
+
+func (x *agentContainerStatsServer) SendAndClose(m *common.Empty) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *agentContainerStatsServer) Recv() (*ContainerStatsMessage, error) {
+	m := new(ContainerStatsMessage)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _Agent_ContainerFileList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ContainerFileListResponse)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentServer).ContainerFileList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/agent.Agent/ContainerFileList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServer).ContainerFileList(ctx, req.(*ContainerFileListResponse))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Agent_ContainerFileDownload_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(AgentServer).ContainerFileDownload(&agentContainerFileDownloadServer{stream})
+}
+
+type Agent_ContainerFileDownloadServer interface {
+	SendAndClose(*common.Empty) error
+	Recv() (*ContainerFileChunk, error)
+	grpc.ServerStream
+}
+
+type agentContainerFileDownloadServer struct {
+	grpc.ServerStream
+}
+
+func (x *agentContainerFileDownloadServer) SendAndClose(m *common.Empty) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *agentContainerFileDownloadServer) Recv() (*ContainerFileChunk, error) {
+	m := new(ContainerFileChunk)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _Agent_ContainerFileUpload_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ContainerFileRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(AgentServer).ContainerFileUpload(m, &agentContainerFileUploadServer{stream})
+}
+
+type Agent_ContainerFileUploadServer interface {
+	Send(*ContainerFileChunk) error
+	grpc.ServerStream
+}
+
+type agentContainerFileUploadServer struct {
+	grpc.ServerStream
+}
+
+func (x *agentContainerFileUploadServer) Send(m *ContainerFileChunk) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Agent_ImagePruneResult_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImagePruneResponse)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentServer).ImagePruneResult(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/agent.Agent/ImagePruneResult",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServer).ImagePruneResult(ctx, req.(*ImagePruneResponse))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Agent_DiskUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiskUsageResponse)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentServer).DiskUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/agent.Agent/DiskUsage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServer).DiskUsage(ctx, req.(*DiskUsageResponse))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Agent_ServiceDesc is the grpc.ServiceDesc for Agent service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -437,6 +1037,22 @@ func (x *agentContainerLogServer) Recv() (*common.ContainerLogMessage, error) {
 			MethodName: "DeleteContainers",
 			Handler:    _Agent_DeleteContainers_Handler,
 		},
+		{
+			MethodName: "CommandResult",
+			Handler:    _Agent_CommandResult_Handler,
+		},
+		{
+			MethodName: "ContainerFileList",
+			Handler:    _Agent_ContainerFileList_Handler,
+		},
+		{
+			MethodName: "ImagePruneResult",
+			Handler:    _Agent_ImagePruneResult_Handler,
+		},
+		{
+			MethodName: "DiskUsage",
+			Handler:    _Agent_DiskUsage_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -459,6 +1075,42 @@ func (x *agentContainerLogServer) Recv() (*common.ContainerLogMessage, error) {
 			Handler:       _Agent_ContainerLog_Handler,
 			ClientStreams: true,
 		},
+		{
+			StreamName:    "VolumeOperationStatus",
+			Handler:       _Agent_VolumeOperationStatus_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "VolumeBackupArchive",
+			Handler:       _Agent_VolumeBackupArchive_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "VolumeRestoreArchive",
+			Handler:       _Agent_VolumeRestoreArchive_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ContainerExec",
+			Handler:       _Agent_ContainerExec_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "ContainerStats",
+			Handler:       _Agent_ContainerStats_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "ContainerFileDownload",
+			Handler:       _Agent_ContainerFileDownload_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "ContainerFileUpload",
+			Handler:       _Agent_ContainerFileUpload_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "protobuf/proto/agent.proto",
 }
@@ -261,6 +261,8 @@ export enum DeploymentStrategy {
   DEPLOYMENT_STRATEGY_UNSPECIFIED = 0,
   RECREATE = 1,
   ROLLING = 2,
+  /** BLUE_GREEN - dagent only: the new container replaces the old one once healthy */
+  BLUE_GREEN = 3,
   UNRECOGNIZED = -1,
 }
 
@@ -275,6 +277,9 @@ export function deploymentStrategyFromJSON(object: any): DeploymentStrategy {
     case 2:
     case 'ROLLING':
       return DeploymentStrategy.ROLLING
+    case 3:
+    case 'BLUE_GREEN':
+      return DeploymentStrategy.BLUE_GREEN
     case -1:
     case 'UNRECOGNIZED':
     default:
@@ -290,12 +295,60 @@ export function deploymentStrategyToJSON(object: DeploymentStrategy): string {
       return 'RECREATE'
     case DeploymentStrategy.ROLLING:
       return 'ROLLING'
+    case DeploymentStrategy.BLUE_GREEN:
+      return 'BLUE_GREEN'
     case DeploymentStrategy.UNRECOGNIZED:
     default:
       return 'UNRECOGNIZED'
   }
 }
 
+/** When the image of a container is pulled, the default is IF_NOT_PRESENT on dagent and the Kubernetes default on crane */
+export enum PullPolicy {
+  PULL_POLICY_UNSPECIFIED = 0,
+  PULL_POLICY_ALWAYS = 1,
+  PULL_POLICY_IF_NOT_PRESENT = 2,
+  PULL_POLICY_NEVER = 3,
+  UNRECOGNIZED = -1,
+}
+
+export function pullPolicyFromJSON(object: any): PullPolicy {
+  switch (object) {
+    case 0:
+    case 'PULL_POLICY_UNSPECIFIED':
+      return PullPolicy.PULL_POLICY_UNSPECIFIED
+    case 1:
+    case 'PULL_POLICY_ALWAYS':
+      return PullPolicy.PULL_POLICY_ALWAYS
+    case 2:
+    case 'PULL_POLICY_IF_NOT_PRESENT':
+      return PullPolicy.PULL_POLICY_IF_NOT_PRESENT
+    case 3:
+    case 'PULL_POLICY_NEVER':
+      return PullPolicy.PULL_POLICY_NEVER
+    case -1:
+    case 'UNRECOGNIZED':
+    default:
+      return PullPolicy.UNRECOGNIZED
+  }
+}
+
+export function pullPolicyToJSON(object: PullPolicy): string {
+  switch (object) {
+    case PullPolicy.PULL_POLICY_UNSPECIFIED:
+      return 'PULL_POLICY_UNSPECIFIED'
+    case PullPolicy.PULL_POLICY_ALWAYS:
+      return 'PULL_POLICY_ALWAYS'
+    case PullPolicy.PULL_POLICY_IF_NOT_PRESENT:
+      return 'PULL_POLICY_IF_NOT_PRESENT'
+    case PullPolicy.PULL_POLICY_NEVER:
+      return 'PULL_POLICY_NEVER'
+    case PullPolicy.UNRECOGNIZED:
+    default:
+      return 'UNRECOGNIZED'
+  }
+}
+
 export enum VolumeType {
   VOLUME_TYPE_UNSPECIFIED = 0,
   RO = 1,
@@ -562,7 +615,11 @@ export interface ContainerStateItemPort {
 
 export interface ContainerStateListMessage {
   prefix?: string | undefined
+  /** When set, data only holds the added and changed containers, otherwise it is the full list */
+  partial: boolean
   data: ContainerStateItem[]
+  /** Containers removed since the previous message, only used when partial is set */
+  removed: ContainerIdentifier[]
 }
 
 export interface ContainerStateItem {
@@ -579,6 +636,8 @@ export interface ContainerStateItem {
   status: string
   imageName: string
   imageTag: string
+  /** The repository digest of the image (sha256:...) if it is known */
+  imageDigest?: string | undefined
   ports: ContainerStateItemPort[]
 }
 
@@ -898,7 +957,7 @@ export const ContainerStateItemPort = {
 }
 
 function createBaseContainerStateListMessage(): ContainerStateListMessage {
-  return { data: [] }
+  return { partial: false, data: [], removed: [] }
 }
 
 export const ContainerStateListMessage = {
@@ -906,9 +965,15 @@ export const ContainerStateListMessage = {
     if (message.prefix !== undefined) {
       writer.uint32(802).string(message.prefix)
     }
+    if (message.partial === true) {
+      writer.uint32(808).bool(message.partial)
+    }
     for (const v of message.data) {
       ContainerStateItem.encode(v!, writer.uint32(8002).fork()).ldelim()
     }
+    for (const v of message.removed) {
+      ContainerIdentifier.encode(v!, writer.uint32(8010).fork()).ldelim()
+    }
     return writer
   },
 
@@ -922,9 +987,15 @@ export const ContainerStateListMessage = {
         case 100:
           message.prefix = reader.string()
           break
+        case 101:
+          message.partial = reader.bool()
+          break
         case 1000:
           message.data.push(ContainerStateItem.decode(reader, reader.uint32()))
           break
+        case 1001:
+          message.removed.push(ContainerIdentifier.decode(reader, reader.uint32()))
+          break
         default:
           reader.skipType(tag & 7)
           break
@@ -936,18 +1007,26 @@ export const ContainerStateListMessage = {
   fromJSON(object: any): ContainerStateListMessage {
     return {
       prefix: isSet(object.prefix) ? String(object.prefix) : undefined,
+      partial: isSet(object.partial) ? Boolean(object.partial) : false,
       data: Array.isArray(object?.data) ? object.data.map((e: any) => ContainerStateItem.fromJSON(e)) : [],
+      removed: Array.isArray(object?.removed) ? object.removed.map((e: any) => ContainerIdentifier.fromJSON(e)) : [],
     }
   },
 
   toJSON(message: ContainerStateListMessage): unknown {
     const obj: any = {}
     message.prefix !== undefined && (obj.prefix = message.prefix)
+    message.partial !== undefined && (obj.partial = message.partial)
     if (message.data) {
       obj.data = message.data.map(e => (e ? ContainerStateItem.toJSON(e) : undefined))
     } else {
       obj.data = []
     }
+    if (message.removed) {
+      obj.removed = message.removed.map(e => (e ? ContainerIdentifier.toJSON(e) : undefined))
+    } else {
+      obj.removed = []
+    }
     return obj
   },
 
@@ -958,7 +1037,9 @@ export const ContainerStateListMessage = {
   fromPartial<I extends Exact<DeepPartial<ContainerStateListMessage>, I>>(object: I): ContainerStateListMessage {
     const message = createBaseContainerStateListMessage()
     message.prefix = object.prefix ?? undefined
+    message.partial = object.partial ?? false
     message.data = object.data?.map(e => ContainerStateItem.fromPartial(e)) || []
+    message.removed = object.removed?.map(e => ContainerIdentifier.fromPartial(e)) || []
     return message
   },
 }
@@ -999,6 +1080,9 @@ export const ContainerStateItem = {
     if (message.imageTag !== '') {
       writer.uint32(850).string(message.imageTag)
     }
+    if (message.imageDigest !== undefined) {
+      writer.uint32(858).string(message.imageDigest)
+    }
     for (const v of message.ports) {
       ContainerStateItemPort.encode(v!, writer.uint32(8002).fork()).ldelim()
     }
@@ -1033,6 +1117,9 @@ export const ContainerStateItem = {
         case 106:
           message.imageTag = reader.string()
           break
+        case 107:
+          message.imageDigest = reader.string()
+          break
         case 1000:
           message.ports.push(ContainerStateItemPort.decode(reader, reader.uint32()))
           break
@@ -1053,6 +1140,7 @@ export const ContainerStateItem = {
       status: isSet(object.status) ? String(object.status) : '',
       imageName: isSet(object.imageName) ? String(object.imageName) : '',
       imageTag: isSet(object.imageTag) ? String(object.imageTag) : '',
+      imageDigest: isSet(object.imageDigest) ? String(object.imageDigest) : undefined,
       ports: Array.isArray(object?.ports) ? object.ports.map((e: any) => ContainerStateItemPort.fromJSON(e)) : [],
     }
   },
@@ -1066,6 +1154,7 @@ export const ContainerStateItem = {
     message.status !== undefined && (obj.status = message.status)
     message.imageName !== undefined && (obj.imageName = message.imageName)
     message.imageTag !== undefined && (obj.imageTag = message.imageTag)
+    message.imageDigest !== undefined && (obj.imageDigest = message.imageDigest)
     if (message.ports) {
       obj.ports = message.ports.map(e => (e ? ContainerStateItemPort.toJSON(e) : undefined))
     } else {
@@ -1088,6 +1177,7 @@ export const ContainerStateItem = {
     message.status = object.status ?? ''
     message.imageName = object.imageName ?? ''
     message.imageTag = object.imageTag ?? ''
+    message.imageDigest = object.imageDigest ?? undefined
     message.ports = object.ports?.map(e => ContainerStateItemPort.fromPartial(e)) || []
     return message
   },
@@ -7,6 +7,18 @@ import {
   AgentController as GrpcAgentController,
   AgentControllerMethods,
   AgentInfo,
+  CommandResultRequest,
+  ContainerExecInput,
+  ContainerExecOutput,
+  ContainerFileChunk,
+  ContainerFileListResponse,
+  ContainerFileRequest,
+  ContainerStatsMessage,
+  DiskUsageResponse,
+  ImagePruneResponse,
+  VolumeArchiveChunk,
+  VolumeArchiveRequest,
+  VolumeOperationStatusMessage,
 } from 'src/grpc/protobuf/proto/agent'
 import {
   ContainerLogMessage,
@@ -57,4 +69,52 @@ export default class AgentController implements GrpcAgentController {
   containerLog(request: Observable<ContainerLogMessage>, _: Metadata, call: NodeGrpcCall): Observable<Empty> {
     return this.service.handleContainerLog(call.connection, request)
   }
+
+  commandResult(request: CommandResultRequest, _: Metadata, call: NodeGrpcCall): Empty {
+    return this.service.handleCommandResult(call.connection, request)
+  }
+
+  volumeOperationStatus(
+    request: Observable<VolumeOperationStatusMessage>,
+    _: Metadata,
+    call: NodeGrpcCall,
+  ): Observable<Empty> {
+    return this.service.handleVolumeOperationStatus(call.connection, request)
+  }
+
+  volumeBackupArchive(_: Observable<VolumeArchiveChunk>, __: Metadata, call: NodeGrpcCall): Observable<Empty> {
+    return this.service.handleVolumeBackupArchive(call.connection)
+  }
+
+  volumeRestoreArchive(request: VolumeArchiveRequest, _: Metadata, call: NodeGrpcCall): Observable<VolumeArchiveChunk> {
+    return this.service.handleVolumeRestoreArchive(call.connection, request)
+  }
+
+  containerExec(_: Observable<ContainerExecOutput>, __: Metadata, call: NodeGrpcCall): Observable<ContainerExecInput> {
+    return this.service.handleContainerExec(call.connection)
+  }
+
+  containerStats(_: Observable<ContainerStatsMessage>, __: Metadata, call: NodeGrpcCall): Observable<Empty> {
+    return this.service.handleContainerStats(call.connection)
+  }
+
+  containerFileList(request: ContainerFileListResponse, _: Metadata, call: NodeGrpcCall): Empty {
+    return this.service.handleContainerFileList(call.connection, request)
+  }
+
+  containerFileDownload(_: Observable<ContainerFileChunk>, __: Metadata, call: NodeGrpcCall): Observable<Empty> {
+    return this.service.handleContainerFileDownload(call.connection)
+  }
+
+  containerFileUpload(request: ContainerFileRequest, _: Metadata, call: NodeGrpcCall): Observable<ContainerFileChunk> {
+    return this.service.handleContainerFileUpload(call.connection, request)
+  }
+
+  imagePruneResult(request: ImagePruneResponse, _: Metadata, call: NodeGrpcCall): Empty {
+    return this.service.handleImagePruneResult(call.connection, request)
+  }
+
+  diskUsage(request: DiskUsageResponse, _: Metadata, call: NodeGrpcCall): Empty {
+    return this.service.handleDiskUsage(call.connection, request)
+  }
 }
@@ -24,7 +24,23 @@ import Deployment, { DeploymentProgressEvent } from 'src/domain/deployment'
 import { DeployMessage, NotificationMessageType } from 'src/domain/notification-templates'
 import { collectChildVersionIds, collectParentVersionIds } from 'src/domain/utils'
 import { AlreadyExistsException, NotFoundException, UnauthenticatedException } from 'src/exception/errors'
-import { AgentAbortUpdate, AgentCommand, AgentInfo, CloseReason } from 'src/grpc/protobuf/proto/agent'
+import {
+  AgentAbortUpdate,
+  AgentCommand,
+  AgentInfo,
+  CloseReason,
+  CommandResultRequest,
+  ContainerExecInput,
+  ContainerFileChunk,
+  ContainerFileListResponse,
+  ContainerFileRequest,
+  DiskUsageResponse,
+  ImagePruneResponse,
+  VolumeArchiveChunk,
+  VolumeArchiveRequest,
+  VolumeOperationStatusMessage,
+  volumeOperationStatusToJSON,
+} from 'src/grpc/protobuf/proto/agent'
 import {
   ContainerIdentifier,
   ContainerLogMessage,
@@ -248,7 +264,9 @@ export default class AgentService {
       // necessary, because of: https://github.com/nestjs/nest/issues/8111
       startWith({
         prefix,
+        partial: false,
         data: [],
+        removed: [],
       }),
       map(it => {
         this.logger.verbose(`${agent.id} - Container status update - ${prefix}`)
@@ -335,6 +353,137 @@ export default class AgentService {
     )
   }
 
+  handleCommandResult(connection: GrpcNodeConnection, request: CommandResultRequest): Empty {
+    const agent = this.getByIdOrThrow(connection.nodeId)
+
+    if (request.success) {
+      this.logger.debug(`${agent.id} - Command finished: ${request.commandId}`)
+    } else {
+      this.logger.warn(`${agent.id} - Command failed: ${request.commandId} with error: '${request.error}'`)
+    }
+
+    return Empty
+  }
+
+  handleVolumeOperationStatus(
+    connection: GrpcNodeConnection,
+    request: Observable<VolumeOperationStatusMessage>,
+  ): Observable<Empty> {
+    const agent = this.getByIdOrThrow(connection.nodeId)
+    const operationId = connection.getMetaData(GrpcNodeConnection.META_VOLUME_OPERATION_ID)
+
+    return request.pipe(
+      map(it => {
+        if (it.status !== undefined) {
+          this.logger.log(`${agent.id} - Volume operation ${operationId}: ${volumeOperationStatusToJSON(it.status)}`)
+        }
+
+        it.log.forEach(log => this.logger.verbose(`${agent.id} - Volume operation ${operationId} - '${log}'`))
+        return Empty
+      }),
+      finalize(() => {
+        this.logger.debug(`${agent.id} - Volume operation status finished: ${operationId}`)
+      }),
+    )
+  }
+
+  handleVolumeBackupArchive(connection: GrpcNodeConnection): Observable<Empty> {
+    const operationId = connection.getMetaData(GrpcNodeConnection.META_VOLUME_OPERATION_ID)
+
+    return this.throwNoTransfer(connection, 'volumeOperation', operationId)
+  }
+
+  handleVolumeRestoreArchive(
+    connection: GrpcNodeConnection,
+    request: VolumeArchiveRequest,
+  ): Observable<VolumeArchiveChunk> {
+    return this.throwNoTransfer(connection, 'volumeOperation', request.id)
+  }
+
+  handleContainerExec(connection: GrpcNodeConnection): Observable<ContainerExecInput> {
+    const execId = connection.getMetaData(GrpcNodeConnection.META_CONTAINER_EXEC_ID)
+
+    return this.throwNoTransfer(connection, 'containerExec', execId)
+  }
+
+  handleContainerStats(connection: GrpcNodeConnection): Observable<Empty> {
+    const agent = this.getByIdOrThrow(connection.nodeId)
+
+    const containerPrefix = connection.getMetaDataOrDefault(GrpcNodeConnection.META_CONTAINER_PREFIX)
+    const containerName = connection.getMetaDataOrDefault(GrpcNodeConnection.META_CONTAINER_NAME)
+
+    const container: ContainerIdentifier = {
+      prefix: containerPrefix ?? '',
+      name: containerName,
+    }
+
+    // closing the call stops the agent from sampling the containers
+    const key = Agent.containerPrefixNameOf(container)
+    this.logger.warn(`${agent.id} - There was no stats stream for ${key}`)
+
+    return of(Empty)
+  }
+
+  handleContainerFileList(connection: GrpcNodeConnection, request: ContainerFileListResponse): Empty {
+    const agent = this.getByIdOrThrow(connection.nodeId)
+
+    this.logger.warn(`${agent.id} - There was no file list request for ${request.id}`)
+
+    return Empty
+  }
+
+  handleContainerFileDownload(connection: GrpcNodeConnection): Observable<Empty> {
+    const fileId = connection.getMetaData(GrpcNodeConnection.META_CONTAINER_FILE_ID)
+
+    return this.throwNoTransfer(connection, 'containerFile', fileId)
+  }
+
+  handleContainerFileUpload(
+    connection: GrpcNodeConnection,
+    request: ContainerFileRequest,
+  ): Observable<ContainerFileChunk> {
+    return this.throwNoTransfer(connection, 'containerFile', request.id)
+  }
+
+  handleImagePruneResult(connection: GrpcNodeConnection, request: ImagePruneResponse): Empty {
+    const agent = this.getByIdOrThrow(connection.nodeId)
+
+    if (request.error) {
+      this.logger.warn(`${agent.id} - Image prune failed with error: '${request.error}'`)
+    }
+
+    const pruned = request.dryRun ? 'Image prune dry run' : 'Images pruned'
+    this.logger.log(`${agent.id} - ${pruned}: ${request.images.length} images, ${request.reclaimedBytes} bytes`)
+
+    return Empty
+  }
+
+  handleDiskUsage(connection: GrpcNodeConnection, request: DiskUsageResponse): Empty {
+    const agent = this.getByIdOrThrow(connection.nodeId)
+
+    if (request.error) {
+      this.logger.warn(`${agent.id} - Disk usage failed with error: '${request.error}'`)
+    } else {
+      this.logger.debug(`${agent.id} - Disk usage: ${request.imagesSize} bytes of images`)
+    }
+
+    return Empty
+  }
+
+  // crux does not store volume archives and has no exec sessions or file transfers to correlate with yet,
+  // the agent fails the command with a not found error instead of an unimplemented one
+  private throwNoTransfer(connection: GrpcNodeConnection, property: string, id: string): never {
+    const agent = this.getByIdOrThrow(connection.nodeId)
+
+    this.logger.warn(`${agent.id} - There was no ${property} for ${id}`)
+
+    throw new NotFoundException({
+      message: 'Transfer not found',
+      property,
+      value: id,
+    })
+  }
+
   private async onAgentConnectionStatusChange(agent: Agent, status: NodeConnectionStatus) {
     if (status === NodeConnectionStatus.UNREACHABLE) {
       this.logger.log(`Left: ${agent.id}`)
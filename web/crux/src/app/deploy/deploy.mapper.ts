@@ -292,6 +292,7 @@ export default class DeployMapper {
       networkMode: this.imageMapper.networkModeToProto(config.networkMode),
       restartPolicy: this.imageMapper.restartPolicyToProto(config.restartPolicy),
       labels: this.mapKeyValueToMap(config.dockerLabels),
+      secretFiles: [],
     }
   }
 
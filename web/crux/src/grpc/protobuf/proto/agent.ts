@@ -2,6 +2,8 @@
 import { Metadata } from '@grpc/grpc-js'
 import { GrpcMethod, GrpcStreamMethod } from '@nestjs/microservices'
 import { Observable } from 'rxjs'
+import { Duration } from '../../google/protobuf/duration'
+import { Timestamp } from '../../google/protobuf/timestamp'
 import {
   ConfigContainer,
   ContainerCommandRequest,
@@ -26,6 +28,9 @@ import {
   NetworkMode,
   networkModeFromJSON,
   networkModeToJSON,
+  PullPolicy,
+  pullPolicyFromJSON,
+  pullPolicyToJSON,
   ResourceConfig,
   RestartPolicy,
   restartPolicyFromJSON,
@@ -42,6 +47,153 @@ export const protobufPackage = 'agent'
  * Logs, statuses, deployments
  */
 
+export enum AgentCapability {
+  AGENT_CAPABILITY_UNSPECIFIED = 0,
+  CAPABILITY_DEPLOY = 1,
+  CAPABILITY_CANCEL_DEPLOYMENT = 2,
+  CAPABILITY_CONTAINER_STATE = 3,
+  CAPABILITY_CONTAINER_STATE_EVENTS = 4,
+  CAPABILITY_CONTAINER_DELETE = 5,
+  CAPABILITY_DELETE_CONTAINERS = 6,
+  CAPABILITY_CONTAINER_COMMAND = 7,
+  CAPABILITY_CONTAINER_LOG = 8,
+  CAPABILITY_LIST_SECRETS = 9,
+  CAPABILITY_UPDATE = 10,
+  CAPABILITY_CLOSE = 11,
+  CAPABILITY_VOLUME_BACKUP = 12,
+  CAPABILITY_VOLUME_RESTORE = 13,
+  CAPABILITY_CONTAINER_EXEC = 14,
+  CAPABILITY_CONTAINER_STATS = 15,
+  CAPABILITY_CONTAINER_FILE_LIST = 16,
+  CAPABILITY_CONTAINER_FILE_DOWNLOAD = 17,
+  CAPABILITY_CONTAINER_FILE_UPLOAD = 18,
+  CAPABILITY_IMAGE_PRUNE = 19,
+  CAPABILITY_DISK_USAGE = 20,
+  UNRECOGNIZED = -1,
+}
+
+export function agentCapabilityFromJSON(object: any): AgentCapability {
+  switch (object) {
+    case 0:
+    case 'AGENT_CAPABILITY_UNSPECIFIED':
+      return AgentCapability.AGENT_CAPABILITY_UNSPECIFIED
+    case 1:
+    case 'CAPABILITY_DEPLOY':
+      return AgentCapability.CAPABILITY_DEPLOY
+    case 2:
+    case 'CAPABILITY_CANCEL_DEPLOYMENT':
+      return AgentCapability.CAPABILITY_CANCEL_DEPLOYMENT
+    case 3:
+    case 'CAPABILITY_CONTAINER_STATE':
+      return AgentCapability.CAPABILITY_CONTAINER_STATE
+    case 4:
+    case 'CAPABILITY_CONTAINER_STATE_EVENTS':
+      return AgentCapability.CAPABILITY_CONTAINER_STATE_EVENTS
+    case 5:
+    case 'CAPABILITY_CONTAINER_DELETE':
+      return AgentCapability.CAPABILITY_CONTAINER_DELETE
+    case 6:
+    case 'CAPABILITY_DELETE_CONTAINERS':
+      return AgentCapability.CAPABILITY_DELETE_CONTAINERS
+    case 7:
+    case 'CAPABILITY_CONTAINER_COMMAND':
+      return AgentCapability.CAPABILITY_CONTAINER_COMMAND
+    case 8:
+    case 'CAPABILITY_CONTAINER_LOG':
+      return AgentCapability.CAPABILITY_CONTAINER_LOG
+    case 9:
+    case 'CAPABILITY_LIST_SECRETS':
+      return AgentCapability.CAPABILITY_LIST_SECRETS
+    case 10:
+    case 'CAPABILITY_UPDATE':
+      return AgentCapability.CAPABILITY_UPDATE
+    case 11:
+    case 'CAPABILITY_CLOSE':
+      return AgentCapability.CAPABILITY_CLOSE
+    case 12:
+    case 'CAPABILITY_VOLUME_BACKUP':
+      return AgentCapability.CAPABILITY_VOLUME_BACKUP
+    case 13:
+    case 'CAPABILITY_VOLUME_RESTORE':
+      return AgentCapability.CAPABILITY_VOLUME_RESTORE
+    case 14:
+    case 'CAPABILITY_CONTAINER_EXEC':
+      return AgentCapability.CAPABILITY_CONTAINER_EXEC
+    case 15:
+    case 'CAPABILITY_CONTAINER_STATS':
+      return AgentCapability.CAPABILITY_CONTAINER_STATS
+    case 16:
+    case 'CAPABILITY_CONTAINER_FILE_LIST':
+      return AgentCapability.CAPABILITY_CONTAINER_FILE_LIST
+    case 17:
+    case 'CAPABILITY_CONTAINER_FILE_DOWNLOAD':
+      return AgentCapability.CAPABILITY_CONTAINER_FILE_DOWNLOAD
+    case 18:
+    case 'CAPABILITY_CONTAINER_FILE_UPLOAD':
+      return AgentCapability.CAPABILITY_CONTAINER_FILE_UPLOAD
+    case 19:
+    case 'CAPABILITY_IMAGE_PRUNE':
+      return AgentCapability.CAPABILITY_IMAGE_PRUNE
+    case 20:
+    case 'CAPABILITY_DISK_USAGE':
+      return AgentCapability.CAPABILITY_DISK_USAGE
+    case -1:
+    case 'UNRECOGNIZED':
+    default:
+      return AgentCapability.UNRECOGNIZED
+  }
+}
+
+export function agentCapabilityToJSON(object: AgentCapability): string {
+  switch (object) {
+    case AgentCapability.AGENT_CAPABILITY_UNSPECIFIED:
+      return 'AGENT_CAPABILITY_UNSPECIFIED'
+    case AgentCapability.CAPABILITY_DEPLOY:
+      return 'CAPABILITY_DEPLOY'
+    case AgentCapability.CAPABILITY_CANCEL_DEPLOYMENT:
+      return 'CAPABILITY_CANCEL_DEPLOYMENT'
+    case AgentCapability.CAPABILITY_CONTAINER_STATE:
+      return 'CAPABILITY_CONTAINER_STATE'
+    case AgentCapability.CAPABILITY_CONTAINER_STATE_EVENTS:
+      return 'CAPABILITY_CONTAINER_STATE_EVENTS'
+    case AgentCapability.CAPABILITY_CONTAINER_DELETE:
+      return 'CAPABILITY_CONTAINER_DELETE'
+    case AgentCapability.CAPABILITY_DELETE_CONTAINERS:
+      return 'CAPABILITY_DELETE_CONTAINERS'
+    case AgentCapability.CAPABILITY_CONTAINER_COMMAND:
+      return 'CAPABILITY_CONTAINER_COMMAND'
+    case AgentCapability.CAPABILITY_CONTAINER_LOG:
+      return 'CAPABILITY_CONTAINER_LOG'
+    case AgentCapability.CAPABILITY_LIST_SECRETS:
+      return 'CAPABILITY_LIST_SECRETS'
+    case AgentCapability.CAPABILITY_UPDATE:
+      return 'CAPABILITY_UPDATE'
+    case AgentCapability.CAPABILITY_CLOSE:
+      return 'CAPABILITY_CLOSE'
+    case AgentCapability.CAPABILITY_VOLUME_BACKUP:
+      return 'CAPABILITY_VOLUME_BACKUP'
+    case AgentCapability.CAPABILITY_VOLUME_RESTORE:
+      return 'CAPABILITY_VOLUME_RESTORE'
+    case AgentCapability.CAPABILITY_CONTAINER_EXEC:
+      return 'CAPABILITY_CONTAINER_EXEC'
+    case AgentCapability.CAPABILITY_CONTAINER_STATS:
+      return 'CAPABILITY_CONTAINER_STATS'
+    case AgentCapability.CAPABILITY_CONTAINER_FILE_LIST:
+      return 'CAPABILITY_CONTAINER_FILE_LIST'
+    case AgentCapability.CAPABILITY_CONTAINER_FILE_DOWNLOAD:
+      return 'CAPABILITY_CONTAINER_FILE_DOWNLOAD'
+    case AgentCapability.CAPABILITY_CONTAINER_FILE_UPLOAD:
+      return 'CAPABILITY_CONTAINER_FILE_UPLOAD'
+    case AgentCapability.CAPABILITY_IMAGE_PRUNE:
+      return 'CAPABILITY_IMAGE_PRUNE'
+    case AgentCapability.CAPABILITY_DISK_USAGE:
+      return 'CAPABILITY_DISK_USAGE'
+    case AgentCapability.UNRECOGNIZED:
+    default:
+      return 'UNRECOGNIZED'
+  }
+}
+
 /** Connection close */
 export enum CloseReason {
   CLOSE_REASON_UNSPECIFIED = 0,
@@ -88,11 +240,126 @@ export function closeReasonToJSON(object: CloseReason): string {
   }
 }
 
+export enum VolumeOperationStatus {
+  VOLUME_OPERATION_STATUS_UNSPECIFIED = 0,
+  VOLUME_OPERATION_IN_PROGRESS = 1,
+  VOLUME_OPERATION_SUCCESSFUL = 2,
+  VOLUME_OPERATION_FAILED = 3,
+  UNRECOGNIZED = -1,
+}
+
+export function volumeOperationStatusFromJSON(object: any): VolumeOperationStatus {
+  switch (object) {
+    case 0:
+    case 'VOLUME_OPERATION_STATUS_UNSPECIFIED':
+      return VolumeOperationStatus.VOLUME_OPERATION_STATUS_UNSPECIFIED
+    case 1:
+    case 'VOLUME_OPERATION_IN_PROGRESS':
+      return VolumeOperationStatus.VOLUME_OPERATION_IN_PROGRESS
+    case 2:
+    case 'VOLUME_OPERATION_SUCCESSFUL':
+      return VolumeOperationStatus.VOLUME_OPERATION_SUCCESSFUL
+    case 3:
+    case 'VOLUME_OPERATION_FAILED':
+      return VolumeOperationStatus.VOLUME_OPERATION_FAILED
+    case -1:
+    case 'UNRECOGNIZED':
+    default:
+      return VolumeOperationStatus.UNRECOGNIZED
+  }
+}
+
+export function volumeOperationStatusToJSON(object: VolumeOperationStatus): string {
+  switch (object) {
+    case VolumeOperationStatus.VOLUME_OPERATION_STATUS_UNSPECIFIED:
+      return 'VOLUME_OPERATION_STATUS_UNSPECIFIED'
+    case VolumeOperationStatus.VOLUME_OPERATION_IN_PROGRESS:
+      return 'VOLUME_OPERATION_IN_PROGRESS'
+    case VolumeOperationStatus.VOLUME_OPERATION_SUCCESSFUL:
+      return 'VOLUME_OPERATION_SUCCESSFUL'
+    case VolumeOperationStatus.VOLUME_OPERATION_FAILED:
+      return 'VOLUME_OPERATION_FAILED'
+    case VolumeOperationStatus.UNRECOGNIZED:
+    default:
+      return 'UNRECOGNIZED'
+  }
+}
+
+export enum FileType {
+  FILE_TYPE_UNSPECIFIED = 0,
+  FILE_TYPE_REGULAR = 1,
+  FILE_TYPE_DIRECTORY = 2,
+  FILE_TYPE_SYMLINK = 3,
+  FILE_TYPE_OTHER = 4,
+  UNRECOGNIZED = -1,
+}
+
+export function fileTypeFromJSON(object: any): FileType {
+  switch (object) {
+    case 0:
+    case 'FILE_TYPE_UNSPECIFIED':
+      return FileType.FILE_TYPE_UNSPECIFIED
+    case 1:
+    case 'FILE_TYPE_REGULAR':
+      return FileType.FILE_TYPE_REGULAR
+    case 2:
+    case 'FILE_TYPE_DIRECTORY':
+      return FileType.FILE_TYPE_DIRECTORY
+    case 3:
+    case 'FILE_TYPE_SYMLINK':
+      return FileType.FILE_TYPE_SYMLINK
+    case 4:
+    case 'FILE_TYPE_OTHER':
+      return FileType.FILE_TYPE_OTHER
+    case -1:
+    case 'UNRECOGNIZED':
+    default:
+      return FileType.UNRECOGNIZED
+  }
+}
+
+export function fileTypeToJSON(object: FileType): string {
+  switch (object) {
+    case FileType.FILE_TYPE_UNSPECIFIED:
+      return 'FILE_TYPE_UNSPECIFIED'
+    case FileType.FILE_TYPE_REGULAR:
+      return 'FILE_TYPE_REGULAR'
+    case FileType.FILE_TYPE_DIRECTORY:
+      return 'FILE_TYPE_DIRECTORY'
+    case FileType.FILE_TYPE_SYMLINK:
+      return 'FILE_TYPE_SYMLINK'
+    case FileType.FILE_TYPE_OTHER:
+      return 'FILE_TYPE_OTHER'
+    case FileType.UNRECOGNIZED:
+    default:
+      return 'UNRECOGNIZED'
+  }
+}
+
 /**  */
 export interface AgentInfo {
   id: string
   version: string
   publicKey: string
+  runtime?: AgentRuntimeInfo | undefined
+  /**
+   * Commands implemented by the agent, crux should not send
+   * anything else.
+   */
+  capabilities: AgentCapability[]
+}
+
+/**
+ * Details of the host the agent is running on, the runtime fields
+ * are set by the agent type, eg. kubernetesVersion only by crane.
+ */
+export interface AgentRuntimeInfo {
+  os: string
+  arch: string
+  containerRuntime?: string | undefined
+  containerRuntimeVersion?: string | undefined
+  kubernetesVersion?: string | undefined
+  traefikEnabled?: boolean | undefined
 }
 
 export interface AgentCommand {
@@ -106,6 +373,37 @@ export interface AgentCommand {
   containerCommand?: ContainerCommandRequest | undefined
   deleteContainers?: DeleteContainersRequest | undefined
   containerLog?: ContainerLogRequest | undefined
+  cancelDeployment?: CancelDeploymentRequest | undefined
+  volumeBackup?: VolumeBackupRequest | undefined
+  volumeRestore?: VolumeRestoreRequest | undefined
+  containerExec?: ContainerExecRequest | undefined
+  containerStats?: ContainerStatsRequest | undefined
+  containerFileList?: ContainerFileListRequest | undefined
+  containerFileDownload?: ContainerFileDownloadRequest | undefined
+  containerFileUpload?: ContainerFileUploadRequest | undefined
+  imagePrune?: ImagePruneRequest | undefined
+  diskUsage?: DiskUsageRequest | undefined
+  /** Correlation ID, echoed back in the CommandResultRequest */
+  commandId: string
+  /**
+   * W3C trace context of the command (traceparent, tracestate),
+   * the same keys the agent sends in the gRPC metadata of the
+   * streams it opens, next to dyo-deployment-id.
+   */
+  traceContext: { [key: string]: string }
+}
+
+export interface AgentCommand_TraceContextEntry {
+  key: string
+  value: string
+}
+
+/** Command result */
+export interface CommandResultRequest {
+  commandId: string
+  success: boolean
+  error?: string | undefined
+  duration: Duration | undefined
 }
 
 /**
@@ -123,6 +421,11 @@ export interface VersionDeployRequest {
   requests: DeployRequest[]
 }
 
+/** Cancels a running VersionDeployRequest or DeployRequestLegacy by its id */
+export interface CancelDeploymentRequest {
+  id: string
+}
+
 /** Request for a keys of existing secrets in a prefix, eg. namespace */
 export interface ListSecretsRequest {
   prefix: string
@@ -244,8 +547,13 @@ export interface DagentContainerConfig {
   logConfig?: LogConfig | undefined
   restartPolicy?: RestartPolicy | undefined
   networkMode?: NetworkMode | undefined
+  deploymentStrategy?: DeploymentStrategy | undefined
+  healthCheckConfig?: HealthCheckConfig | undefined
+  resourceConfig?: ResourceConfig | undefined
   networks: string[]
   labels: { [key: string]: string }
+  /** keys of the secrets written to files in /run/secrets instead of env variables */
+  secretFiles: string[]
 }
 
 export interface DagentContainerConfig_LabelsEntry {
@@ -284,6 +592,7 @@ export interface CommonContainerConfig {
   importContainer?: ImportContainer | undefined
   user?: number | undefined
   TTY?: boolean | undefined
+  pullPolicy?: PullPolicy | undefined
   ports: Port[]
   portRanges: PortRangeBinding[]
   volumes: Volume[]
@@ -319,6 +628,8 @@ export interface DeployRequest {
 export interface ContainerStateRequest {
   prefix?: string | undefined
   oneShot?: boolean | undefined
+  /** Set when crux can merge the partial messages, otherwise every message has the full list */
+  partial?: boolean | undefined
 }
 
 export interface ContainerDeleteRequest {
@@ -353,10 +664,226 @@ export interface CloseConnectionRequest {
   reason: CloseReason
 }
 
+/** Volume backup and restore (docker only) */
+export interface VolumeBackupRequest {
+  id: string
+  container: ContainerIdentifier | undefined
+  volume: string
+  /** the container is stopped while archiving, then started again */
+  stopContainer: boolean
+  /** rclone remote path of the archive, eg. s3:bucket/backup.tar.gz */
+  remote?: string | undefined
+  /** rclone configuration of the remote, eg. RCLONE_CONFIG_S3_TYPE */
+  environment: { [key: string]: string }
+}
+
+export interface VolumeBackupRequest_EnvironmentEntry {
+  key: string
+  value: string
+}
+
+export interface VolumeRestoreRequest {
+  id: string
+  container: ContainerIdentifier | undefined
+  volume: string
+  /** the container is stopped while extracting, then started again */
+  stopContainer: boolean
+  /** rclone remote path of the archive, eg. s3:bucket/backup.tar.gz */
+  remote?: string | undefined
+  /** rclone configuration of the remote, eg. RCLONE_CONFIG_S3_TYPE */
+  environment: { [key: string]: string }
+}
+
+export interface VolumeRestoreRequest_EnvironmentEntry {
+  key: string
+  value: string
+}
+
+export interface VolumeArchiveRequest {
+  id: string
+}
+
+/** part of a gzip compressed tar archive of a volume */
+export interface VolumeArchiveChunk {
+  data: Uint8Array
+}
+
+export interface VolumeOperationStatusMessage {
+  status?: VolumeOperationStatus | undefined
+  /** size of the archive processed so far */
+  bytes?: number | undefined
+  log: string[]
+}
+
+/** Container exec */
+export interface ContainerExecRequest {
+  id: string
+  container: ContainerIdentifier | undefined
+  /** the shell of the container is used if empty */
+  command: string[]
+  tty: boolean
+}
+
+export interface TerminalSize {
+  width: number
+  height: number
+}
+
+export interface ContainerExecInput {
+  stdin?: Uint8Array | undefined
+  resize?: TerminalSize | undefined
+  /** closes the stdin of the process, eg. ctrl+d */
+  closeStdin?: boolean | undefined
+}
+
+export interface ContainerExecOutput {
+  stdout?: Uint8Array | undefined
+  stderr?: Uint8Array | undefined
+  /** sent last, when the process exited */
+  exitCode?: number | undefined
+  /** sent last, when the session could not be started or broke */
+  error?: string | undefined
+}
+
+/** Container stats */
+export interface ContainerStatsRequest {
+  prefix: string
+  /** every container of the prefix is sampled if empty */
+  name?: string | undefined
+  /** a default interval is used if zero */
+  intervalSeconds: number
+}
+
+export interface ContainerStats {
+  container: ContainerIdentifier | undefined
+  /** 100 is one fully used CPU core */
+  cpuPercent: number
+  memoryUsage: number
+  /** zero if unknown, the memory of the host if unlimited */
+  memoryLimit: number
+  networkRxBytes: number
+  networkTxBytes: number
+  blockReadBytes: number
+  blockWriteBytes: number
+}
+
+export interface ContainerStatsMessage {
+  timestamp: Timestamp | undefined
+  stats: ContainerStats[]
+}
+
+/** Container files */
+export interface ContainerFileListRequest {
+  id: string
+  container: ContainerIdentifier | undefined
+  /** absolute path of a directory */
+  path: string
+}
+
+export interface ContainerFileEntry {
+  name: string
+  type: FileType
+  size: number
+  /** permission bits, eg. 0755 */
+  mode: number
+  modifiedAt: Timestamp | undefined
+}
+
+export interface ContainerFileListResponse {
+  id: string
+  error?: string | undefined
+  entries: ContainerFileEntry[]
+}
+
+/** the file or directory is downloaded as a tar archive */
+export interface ContainerFileDownloadRequest {
+  id: string
+  container: ContainerIdentifier | undefined
+  path: string
+}
+
+/** the file content is fetched by ContainerFileUpload */
+export interface ContainerFileUploadRequest {
+  id: string
+  container: ContainerIdentifier | undefined
+  /** absolute path of the target directory */
+  path: string
+  fileName: string
+  uid: number
+  gid: number
+  /** permission bits, 0644 if zero */
+  mode: number
+  /** size of the file in bytes, the uploaded content has to match it */
+  size: number
+}
+
+export interface ContainerFileRequest {
+  id: string
+}
+
+export interface ContainerFileChunk {
+  data: Uint8Array
+}
+
+/** Image garbage collection (docker only) */
+export interface ImagePruneRequest {
+  id: string
+  /** lists the images without removing them */
+  dryRun: boolean
+  /** the retention configured on the agent is used if not set */
+  keepTags?: number | undefined
+  minAge?: Duration | undefined
+}
+
+export interface PrunedImage {
+  id: string
+  size: number
+  createdAt: Timestamp | undefined
+  repoTags: string[]
+}
+
+export interface ImagePruneResponse {
+  id: string
+  dryRun: boolean
+  reclaimedBytes: number
+  error?: string | undefined
+  images: PrunedImage[]
+}
+
+/** Disk usage (docker only) */
+export interface DiskUsageRequest {
+  id: string
+  /** every prefix is reported if empty */
+  prefix?: string | undefined
+}
+
+export interface PrefixDiskUsage {
+  prefix: string
+  containers: number
+  /** writable layers of the containers */
+  containersSize: number
+  /** images used by the containers, shared images are counted for each prefix */
+  imagesSize: number
+  /** named volumes */
+  volumesSize: number
+  /** host directories of the bind mounted volumes */
+  mountsSize: number
+}
+
+export interface DiskUsageResponse {
+  id: string
+  imagesSize: number
+  /** images not used by any container */
+  reclaimableImagesSize: number
+  buildCacheSize: number
+  error?: string | undefined
+  prefixes: PrefixDiskUsage[]
+}
+
 export const AGENT_PACKAGE_NAME = 'agent'
 
 function createBaseAgentInfo(): AgentInfo {
-  return { id: '', version: '', publicKey: '' }
+  return { id: '', version: '', publicKey: '', capabilities: [] }
 }
 
 export const AgentInfo = {
@@ -365,6 +892,10 @@ export const AgentInfo = {
       id: isSet(object.id) ? String(object.id) : '',
       version: isSet(object.version) ? String(object.version) : '',
       publicKey: isSet(object.publicKey) ? String(object.publicKey) : '',
+      runtime: isSet(object.runtime) ? AgentRuntimeInfo.fromJSON(object.runtime) : undefined,
+      capabilities: Array.isArray(object?.capabilities)
+        ? object.capabilities.map((e: any) => agentCapabilityFromJSON(e))
+        : [],
     }
   },
 
@@ -373,12 +904,49 @@ export const AgentInfo = {
     message.id !== undefined && (obj.id = message.id)
     message.version !== undefined && (obj.version = message.version)
     message.publicKey !== undefined && (obj.publicKey = message.publicKey)
+    message.runtime !== undefined &&
+      (obj.runtime = message.runtime ? AgentRuntimeInfo.toJSON(message.runtime) : undefined)
+    if (message.capabilities) {
+      obj.capabilities = message.capabilities.map(e => agentCapabilityToJSON(e))
+    } else {
+      obj.capabilities = []
+    }
+    return obj
+  },
+}
+
+function createBaseAgentRuntimeInfo(): AgentRuntimeInfo {
+  return { os: '', arch: '' }
+}
+
+export const AgentRuntimeInfo = {
+  fromJSON(object: any): AgentRuntimeInfo {
+    return {
+      os: isSet(object.os) ? String(object.os) : '',
+      arch: isSet(object.arch) ? String(object.arch) : '',
+      containerRuntime: isSet(object.containerRuntime) ? String(object.containerRuntime) : undefined,
+      containerRuntimeVersion: isSet(object.containerRuntimeVersion)
+        ? String(object.containerRuntimeVersion)
+        : undefined,
+      kubernetesVersion: isSet(object.kubernetesVersion) ? String(object.kubernetesVersion) : undefined,
+      traefikEnabled: isSet(object.traefikEnabled) ? Boolean(object.traefikEnabled) : undefined,
+    }
+  },
+
+  toJSON(message: AgentRuntimeInfo): unknown {
+    const obj: any = {}
+    message.os !== undefined && (obj.os = message.os)
+    message.arch !== undefined && (obj.arch = message.arch)
+    message.containerRuntime !== undefined && (obj.containerRuntime = message.containerRuntime)
+    message.containerRuntimeVersion !== undefined && (obj.containerRuntimeVersion = message.containerRuntimeVersion)
+    message.kubernetesVersion !== undefined && (obj.kubernetesVersion = message.kubernetesVersion)
+    message.traefikEnabled !== undefined && (obj.traefikEnabled = message.traefikEnabled)
     return obj
   },
 }
 
 function createBaseAgentCommand(): AgentCommand {
-  return {}
+  return { commandId: '', traceContext: {} }
 }
 
 export const AgentCommand = {
@@ -400,6 +968,31 @@ export const AgentCommand = {
         ? DeleteContainersRequest.fromJSON(object.deleteContainers)
         : undefined,
       containerLog: isSet(object.containerLog) ? ContainerLogRequest.fromJSON(object.containerLog) : undefined,
+      cancelDeployment: isSet(object.cancelDeployment)
+        ? CancelDeploymentRequest.fromJSON(object.cancelDeployment)
+        : undefined,
+      volumeBackup: isSet(object.volumeBackup) ? VolumeBackupRequest.fromJSON(object.volumeBackup) : undefined,
+      volumeRestore: isSet(object.volumeRestore) ? VolumeRestoreRequest.fromJSON(object.volumeRestore) : undefined,
+      containerExec: isSet(object.containerExec) ? ContainerExecRequest.fromJSON(object.containerExec) : undefined,
+      containerStats: isSet(object.containerStats) ? ContainerStatsRequest.fromJSON(object.containerStats) : undefined,
+      containerFileList: isSet(object.containerFileList)
+        ? ContainerFileListRequest.fromJSON(object.containerFileList)
+        : undefined,
+      containerFileDownload: isSet(object.containerFileDownload)
+        ? ContainerFileDownloadRequest.fromJSON(object.containerFileDownload)
+        : undefined,
+      containerFileUpload: isSet(object.containerFileUpload)
+        ? ContainerFileUploadRequest.fromJSON(object.containerFileUpload)
+        : undefined,
+      imagePrune: isSet(object.imagePrune) ? ImagePruneRequest.fromJSON(object.imagePrune) : undefined,
+      diskUsage: isSet(object.diskUsage) ? DiskUsageRequest.fromJSON(object.diskUsage) : undefined,
+      commandId: isSet(object.commandId) ? String(object.commandId) : '',
+      traceContext: isObject(object.traceContext)
+        ? Object.entries(object.traceContext).reduce<{ [key: string]: string }>((acc, [key, value]) => {
+            acc[key] = String(value)
+            return acc
+          }, {})
+        : {},
     }
   },
 
@@ -431,6 +1024,82 @@ export const AgentCommand = {
         : undefined)
     message.containerLog !== undefined &&
       (obj.containerLog = message.containerLog ? ContainerLogRequest.toJSON(message.containerLog) : undefined)
+    message.cancelDeployment !== undefined &&
+      (obj.cancelDeployment = message.cancelDeployment
+        ? CancelDeploymentRequest.toJSON(message.cancelDeployment)
+        : undefined)
+    message.volumeBackup !== undefined &&
+      (obj.volumeBackup = message.volumeBackup ? VolumeBackupRequest.toJSON(message.volumeBackup) : undefined)
+    message.volumeRestore !== undefined &&
+      (obj.volumeRestore = message.volumeRestore ? VolumeRestoreRequest.toJSON(message.volumeRestore) : undefined)
+    message.containerExec !== undefined &&
+      (obj.containerExec = message.containerExec ? ContainerExecRequest.toJSON(message.containerExec) : undefined)
+    message.containerStats !== undefined &&
+      (obj.containerStats = message.containerStats ? ContainerStatsRequest.toJSON(message.containerStats) : undefined)
+    message.containerFileList !== undefined &&
+      (obj.containerFileList = message.containerFileList
+        ? ContainerFileListRequest.toJSON(message.containerFileList)
+        : undefined)
+    message.containerFileDownload !== undefined &&
+      (obj.containerFileDownload = message.containerFileDownload
+        ? ContainerFileDownloadRequest.toJSON(message.containerFileDownload)
+        : undefined)
+    message.containerFileUpload !== undefined &&
+      (obj.containerFileUpload = message.containerFileUpload
+        ? ContainerFileUploadRequest.toJSON(message.containerFileUpload)
+        : undefined)
+    message.imagePrune !== undefined &&
+      (obj.imagePrune = message.imagePrune ? ImagePruneRequest.toJSON(message.imagePrune) : undefined)
+    message.diskUsage !== undefined &&
+      (obj.diskUsage = message.diskUsage ? DiskUsageRequest.toJSON(message.diskUsage) : undefined)
+    message.commandId !== undefined && (obj.commandId = message.commandId)
+    obj.traceContext = {}
+    if (message.traceContext) {
+      Object.entries(message.traceContext).forEach(([k, v]) => {
+        obj.traceContext[k] = v
+      })
+    }
+    return obj
+  },
+}
+
+function createBaseAgentCommand_TraceContextEntry(): AgentCommand_TraceContextEntry {
+  return { key: '', value: '' }
+}
+
+export const AgentCommand_TraceContextEntry = {
+  fromJSON(object: any): AgentCommand_TraceContextEntry {
+    return { key: isSet(object.key) ? String(object.key) : '', value: isSet(object.value) ? String(object.value) : '' }
+  },
+
+  toJSON(message: AgentCommand_TraceContextEntry): unknown {
+    const obj: any = {}
+    message.key !== undefined && (obj.key = message.key)
+    message.value !== undefined && (obj.value = message.value)
+    return obj
+  },
+}
+
+function createBaseCommandResultRequest(): CommandResultRequest {
+  return { commandId: '', success: false, duration: undefined }
+}
+
+export const CommandResultRequest = {
+  fromJSON(object: any): CommandResultRequest {
+    return {
+      commandId: isSet(object.commandId) ? String(object.commandId) : '',
+      success: isSet(object.success) ? Boolean(object.success) : false,
+      error: isSet(object.error) ? String(object.error) : undefined,
+      duration: isSet(object.duration) ? Duration.fromJSON(object.duration) : undefined,
+    }
+  },
+
+  toJSON(message: CommandResultRequest): unknown {
+    const obj: any = {}
+    message.commandId !== undefined && (obj.commandId = message.commandId)
+    message.success !== undefined && (obj.success = message.success)
+    message.error !== undefined && (obj.error = message.error)
+    message.duration !== undefined && (obj.duration = message.duration ? Duration.toJSON(message.duration) : undefined)
     return obj
   },
 }
@@ -479,6 +1148,22 @@ export const VersionDeployRequest = {
   },
 }
 
+function createBaseCancelDeploymentRequest(): CancelDeploymentRequest {
+  return { id: '' }
+}
+
+export const CancelDeploymentRequest = {
+  fromJSON(object: any): CancelDeploymentRequest {
+    return { id: isSet(object.id) ? String(object.id) : '' }
+  },
+
+  toJSON(message: CancelDeploymentRequest): unknown {
+    const obj: any = {}
+    message.id !== undefined && (obj.id = message.id)
+    return obj
+  },
+}
+
 function createBaseListSecretsRequest(): ListSecretsRequest {
   return { prefix: '', name: '' }
 }
@@ -937,7 +1622,7 @@ export const Marker_IngressEntry = {
 }
 
 function createBaseDagentContainerConfig(): DagentContainerConfig {
-  return { networks: [], labels: {} }
+  return { networks: [], labels: {}, secretFiles: [] }
 }
 
 export const DagentContainerConfig = {
@@ -946,6 +1631,13 @@ export const DagentContainerConfig = {
       logConfig: isSet(object.logConfig) ? LogConfig.fromJSON(object.logConfig) : undefined,
       restartPolicy: isSet(object.restartPolicy) ? restartPolicyFromJSON(object.restartPolicy) : undefined,
       networkMode: isSet(object.networkMode) ? networkModeFromJSON(object.networkMode) : undefined,
+      deploymentStrategy: isSet(object.deploymentStrategy)
+        ? deploymentStrategyFromJSON(object.deploymentStrategy)
+        : undefined,
+      healthCheckConfig: isSet(object.healthCheckConfig)
+        ? HealthCheckConfig.fromJSON(object.healthCheckConfig)
+        : undefined,
+      resourceConfig: isSet(object.resourceConfig) ? ResourceConfig.fromJSON(object.resourceConfig) : undefined,
       networks: Array.isArray(object?.networks) ? object.networks.map((e: any) => String(e)) : [],
       labels: isObject(object.labels)
         ? Object.entries(object.labels).reduce<{ [key: string]: string }>((acc, [key, value]) => {
@@ -953,6 +1645,7 @@ export const DagentContainerConfig = {
             return acc
           }, {})
         : {},
+      secretFiles: Array.isArray(object?.secretFiles) ? object.secretFiles.map((e: any) => String(e)) : [],
     }
   },
 
@@ -964,6 +1657,15 @@ export const DagentContainerConfig = {
       (obj.restartPolicy = message.restartPolicy !== undefined ? restartPolicyToJSON(message.restartPolicy) : undefined)
     message.networkMode !== undefined &&
       (obj.networkMode = message.networkMode !== undefined ? networkModeToJSON(message.networkMode) : undefined)
+    message.deploymentStrategy !== undefined &&
+      (obj.deploymentStrategy =
+        message.deploymentStrategy !== undefined ? deploymentStrategyToJSON(message.deploymentStrategy) : undefined)
+    message.healthCheckConfig !== undefined &&
+      (obj.healthCheckConfig = message.healthCheckConfig
+        ? HealthCheckConfig.toJSON(message.healthCheckConfig)
+        : undefined)
+    message.resourceConfig !== undefined &&
+      (obj.resourceConfig = message.resourceConfig ? ResourceConfig.toJSON(message.resourceConfig) : undefined)
     if (message.networks) {
       obj.networks = message.networks.map(e => e)
     } else {
@@ -975,6 +1677,11 @@ export const DagentContainerConfig = {
         obj.labels[k] = v
       })
     }
+    if (message.secretFiles) {
+      obj.secretFiles = message.secretFiles.map(e => e)
+    } else {
+      obj.secretFiles = []
+    }
     return obj
   },
 }
@@ -1115,6 +1822,7 @@ export const CommonContainerConfig = {
       importContainer: isSet(object.importContainer) ? ImportContainer.fromJSON(object.importContainer) : undefined,
       user: isSet(object.user) ? Number(object.user) : undefined,
       TTY: isSet(object.TTY) ? Boolean(object.TTY) : undefined,
+      pullPolicy: isSet(object.pullPolicy) ? pullPolicyFromJSON(object.pullPolicy) : undefined,
       ports: Array.isArray(object?.ports) ? object.ports.map((e: any) => Port.fromJSON(e)) : [],
       portRanges: Array.isArray(object?.portRanges)
         ? object.portRanges.map((e: any) => PortRangeBinding.fromJSON(e))
@@ -1147,6 +1855,8 @@ export const CommonContainerConfig = {
       (obj.importContainer = message.importContainer ? ImportContainer.toJSON(message.importContainer) : undefined)
     message.user !== undefined && (obj.user = Math.round(message.user))
     message.TTY !== undefined && (obj.TTY = message.TTY)
+    message.pullPolicy !== undefined &&
+      (obj.pullPolicy = message.pullPolicy !== undefined ? pullPolicyToJSON(message.pullPolicy) : undefined)
     if (message.ports) {
       obj.ports = message.ports.map(e => (e ? Port.toJSON(e) : undefined))
     } else {
@@ -1260,6 +1970,7 @@ export const ContainerStateRequest = {
     return {
       prefix: isSet(object.prefix) ? String(object.prefix) : undefined,
       oneShot: isSet(object.oneShot) ? Boolean(object.oneShot) : undefined,
+      partial: isSet(object.partial) ? Boolean(object.partial) : undefined,
     }
   },
 
@@ -1267,6 +1978,7 @@ export const ContainerStateRequest = {
     const obj: any = {}
     message.prefix !== undefined && (obj.prefix = message.prefix)
     message.oneShot !== undefined && (obj.oneShot = message.oneShot)
+    message.partial !== undefined && (obj.partial = message.partial)
     return obj
   },
 }
@@ -1386,16 +2098,701 @@ export const CloseConnectionRequest = {
   },
 }
 
-/** Service handling deployment of containers and fetching statuses */
+function createBaseVolumeBackupRequest(): VolumeBackupRequest {
+  return { id: '', container: undefined, volume: '', stopContainer: false, environment: {} }
+}
 
-export interface AgentClient {
-  /**
-   * Subscribe with pre-assigned AgentID, waiting for incoming
-   * deploy requests and prefix status requests.
-   * In both cases, separate, shorter-living channels are opened.
-   * For deployment status reports, closed when ended.
-   * For prefix state reports, should be closed by the server.
-   */
+export const VolumeBackupRequest = {
+  fromJSON(object: any): VolumeBackupRequest {
+    return {
+      id: isSet(object.id) ? String(object.id) : '',
+      container: isSet(object.container) ? ContainerIdentifier.fromJSON(object.container) : undefined,
+      volume: isSet(object.volume) ? String(object.volume) : '',
+      stopContainer: isSet(object.stopContainer) ? Boolean(object.stopContainer) : false,
+      remote: isSet(object.remote) ? String(object.remote) : undefined,
+      environment: isObject(object.environment)
+        ? Object.entries(object.environment).reduce<{ [key: string]: string }>((acc, [key, value]) => {
+            acc[key] = String(value)
+            return acc
+          }, {})
+        : {},
+    }
+  },
+
+  toJSON(message: VolumeBackupRequest): unknown {
+    const obj: any = {}
+    message.id !== undefined && (obj.id = message.id)
+    message.container !== undefined &&
+      (obj.container = message.container ? ContainerIdentifier.toJSON(message.container) : undefined)
+    message.volume !== undefined && (obj.volume = message.volume)
+    message.stopContainer !== undefined && (obj.stopContainer = message.stopContainer)
+    message.remote !== undefined && (obj.remote = message.remote)
+    obj.environment = {}
+    if (message.environment) {
+      Object.entries(message.environment).forEach(([k, v]) => {
+        obj.environment[k] = v
+      })
+    }
+    return obj
+  },
+}
+
+function createBaseVolumeBackupRequest_EnvironmentEntry(): VolumeBackupRequest_EnvironmentEntry {
+  return { key: '', value: '' }
+}
+
+export const VolumeBackupRequest_EnvironmentEntry = {
+  fromJSON(object: any): VolumeBackupRequest_EnvironmentEntry {
+    return { key: isSet(object.key) ? String(object.key) : '', value: isSet(object.value) ? String(object.value) : '' }
+  },
+
+  toJSON(message: VolumeBackupRequest_EnvironmentEntry): unknown {
+    const obj: any = {}
+    message.key !== undefined && (obj.key = message.key)
+    message.value !== undefined && (obj.value = message.value)
+    return obj
+  },
+}
+
+function createBaseVolumeRestoreRequest(): VolumeRestoreRequest {
+  return { id: '', container: undefined, volume: '', stopContainer: false, environment: {} }
+}
+
+export const VolumeRestoreRequest = {
+  fromJSON(object: any): VolumeRestoreRequest {
+    return {
+      id: isSet(object.id) ? String(object.id) : '',
+      container: isSet(object.container) ? ContainerIdentifier.fromJSON(object.container) : undefined,
+      volume: isSet(object.volume) ? String(object.volume) : '',
+      stopContainer: isSet(object.stopContainer) ? Boolean(object.stopContainer) : false,
+      remote: isSet(object.remote) ? String(object.remote) : undefined,
+      environment: isObject(object.environment)
+        ? Object.entries(object.environment).reduce<{ [key: string]: string }>((acc, [key, value]) => {
+            acc[key] = String(value)
+            return acc
+          }, {})
+        : {},
+    }
+  },
+
+  toJSON(message: VolumeRestoreRequest): unknown {
+    const obj: any = {}
+    message.id !== undefined && (obj.id = message.id)
+    message.container !== undefined &&
+      (obj.container = message.container ? ContainerIdentifier.toJSON(message.container) : undefined)
+    message.volume !== undefined && (obj.volume = message.volume)
+    message.stopContainer !== undefined && (obj.stopContainer = message.stopContainer)
+    message.remote !== undefined && (obj.remote = message.remote)
+    obj.environment = {}
+    if (message.environment) {
+      Object.entries(message.environment).forEach(([k, v]) => {
+        obj.environment[k] = v
+      })
+    }
+    return obj
+  },
+}
+
+function createBaseVolumeRestoreRequest_EnvironmentEntry(): VolumeRestoreRequest_EnvironmentEntry {
+  return { key: '', value: '' }
+}
+
+export const VolumeRestoreRequest_EnvironmentEntry = {
+  fromJSON(object: any): VolumeRestoreRequest_EnvironmentEntry {
+    return { key: isSet(object.key) ? String(object.key) : '', value: isSet(object.value) ? String(object.value) : '' }
+  },
+
+  toJSON(message: VolumeRestoreRequest_EnvironmentEntry): unknown {
+    const obj: any = {}
+    message.key !== undefined && (obj.key = message.key)
+    message.value !== undefined && (obj.value = message.value)
+    return obj
+  },
+}
+
+function createBaseVolumeArchiveRequest(): VolumeArchiveRequest {
+  return { id: '' }
+}
+
+export const VolumeArchiveRequest = {
+  fromJSON(object: any): VolumeArchiveRequest {
+    return { id: isSet(object.id) ? String(object.id) : '' }
+  },
+
+  toJSON(message: VolumeArchiveRequest): unknown {
+    const obj: any = {}
+    message.id !== undefined && (obj.id = message.id)
+    return obj
+  },
+}
+
+function createBaseVolumeArchiveChunk(): VolumeArchiveChunk {
+  return { data: new Uint8Array() }
+}
+
+export const VolumeArchiveChunk = {
+  fromJSON(object: any): VolumeArchiveChunk {
+    return { data: isSet(object.data) ? bytesFromBase64(object.data) : new Uint8Array() }
+  },
+
+  toJSON(message: VolumeArchiveChunk): unknown {
+    const obj: any = {}
+    message.data !== undefined &&
+      (obj.data = base64FromBytes(message.data !== undefined ? message.data : new Uint8Array()))
+    return obj
+  },
+}
+
+function createBaseVolumeOperationStatusMessage(): VolumeOperationStatusMessage {
+  return { log: [] }
+}
+
+export const VolumeOperationStatusMessage = {
+  fromJSON(object: any): VolumeOperationStatusMessage {
+    return {
+      status: isSet(object.status) ? volumeOperationStatusFromJSON(object.status) : undefined,
+      bytes: isSet(object.bytes) ? Number(object.bytes) : undefined,
+      log: Array.isArray(object?.log) ? object.log.map((e: any) => String(e)) : [],
+    }
+  },
+
+  toJSON(message: VolumeOperationStatusMessage): unknown {
+    const obj: any = {}
+    message.status !== undefined &&
+      (obj.status = message.status !== undefined ? volumeOperationStatusToJSON(message.status) : undefined)
+    message.bytes !== undefined && (obj.bytes = Math.round(message.bytes))
+    if (message.log) {
+      obj.log = message.log.map(e => e)
+    } else {
+      obj.log = []
+    }
+    return obj
+  },
+}
+
+function createBaseContainerExecRequest(): ContainerExecRequest {
+  return { id: '', container: undefined, command: [], tty: false }
+}
+
+export const ContainerExecRequest = {
+  fromJSON(object: any): ContainerExecRequest {
+    return {
+      id: isSet(object.id) ? String(object.id) : '',
+      container: isSet(object.container) ? ContainerIdentifier.fromJSON(object.container) : undefined,
+      command: Array.isArray(object?.command) ? object.command.map((e: any) => String(e)) : [],
+      tty: isSet(object.tty) ? Boolean(object.tty) : false,
+    }
+  },
+
+  toJSON(message: ContainerExecRequest): unknown {
+    const obj: any = {}
+    message.id !== undefined && (obj.id = message.id)
+    message.container !== undefined &&
+      (obj.container = message.container ? ContainerIdentifier.toJSON(message.container) : undefined)
+    if (message.command) {
+      obj.command = message.command.map(e => e)
+    } else {
+      obj.command = []
+    }
+    message.tty !== undefined && (obj.tty = message.tty)
+    return obj
+  },
+}
+
+function createBaseTerminalSize(): TerminalSize {
+  return { width: 0, height: 0 }
+}
+
+export const TerminalSize = {
+  fromJSON(object: any): TerminalSize {
+    return {
+      width: isSet(object.width) ? Number(object.width) : 0,
+      height: isSet(object.height) ? Number(object.height) : 0,
+    }
+  },
+
+  toJSON(message: TerminalSize): unknown {
+    const obj: any = {}
+    message.width !== undefined && (obj.width = Math.round(message.width))
+    message.height !== undefined && (obj.height = Math.round(message.height))
+    return obj
+  },
+}
+
+function createBaseContainerExecInput(): ContainerExecInput {
+  return {}
+}
+
+export const ContainerExecInput = {
+  fromJSON(object: any): ContainerExecInput {
+    return {
+      stdin: isSet(object.stdin) ? bytesFromBase64(object.stdin) : undefined,
+      resize: isSet(object.resize) ? TerminalSize.fromJSON(object.resize) : undefined,
+      closeStdin: isSet(object.closeStdin) ? Boolean(object.closeStdin) : undefined,
+    }
+  },
+
+  toJSON(message: ContainerExecInput): unknown {
+    const obj: any = {}
+    message.stdin !== undefined &&
+      (obj.stdin = message.stdin !== undefined ? base64FromBytes(message.stdin) : undefined)
+    message.resize !== undefined && (obj.resize = message.resize ? TerminalSize.toJSON(message.resize) : undefined)
+    message.closeStdin !== undefined && (obj.closeStdin = message.closeStdin)
+    return obj
+  },
+}
+
+function createBaseContainerExecOutput(): ContainerExecOutput {
+  return {}
+}
+
+export const ContainerExecOutput = {
+  fromJSON(object: any): ContainerExecOutput {
+    return {
+      stdout: isSet(object.stdout) ? bytesFromBase64(object.stdout) : undefined,
+      stderr: isSet(object.stderr) ? bytesFromBase64(object.stderr) : undefined,
+      exitCode: isSet(object.exitCode) ? Number(object.exitCode) : undefined,
+      error: isSet(object.error) ? String(object.error) : undefined,
+    }
+  },
+
+  toJSON(message: ContainerExecOutput): unknown {
+    const obj: any = {}
+    message.stdout !== undefined &&
+      (obj.stdout = message.stdout !== undefined ? base64FromBytes(message.stdout) : undefined)
+    message.stderr !== undefined &&
+      (obj.stderr = message.stderr !== undefined ? base64FromBytes(message.stderr) : undefined)
+    message.exitCode !== undefined && (obj.exitCode = Math.round(message.exitCode))
+    message.error !== undefined && (obj.error = message.error)
+    return obj
+  },
+}
+
+function createBaseContainerStatsRequest(): ContainerStatsRequest {
+  return { prefix: '', intervalSeconds: 0 }
+}
+
+export const ContainerStatsRequest = {
+  fromJSON(object: any): ContainerStatsRequest {
+    return {
+      prefix: isSet(object.prefix) ? String(object.prefix) : '',
+      name: isSet(object.name) ? String(object.name) : undefined,
+      intervalSeconds: isSet(object.intervalSeconds) ? Number(object.intervalSeconds) : 0,
+    }
+  },
+
+  toJSON(message: ContainerStatsRequest): unknown {
+    const obj: any = {}
+    message.prefix !== undefined && (obj.prefix = message.prefix)
+    message.name !== undefined && (obj.name = message.name)
+    message.intervalSeconds !== undefined && (obj.intervalSeconds = Math.round(message.intervalSeconds))
+    return obj
+  },
+}
+
+function createBaseContainerStats(): ContainerStats {
+  return {
+    container: undefined,
+    cpuPercent: 0,
+    memoryUsage: 0,
+    memoryLimit: 0,
+    networkRxBytes: 0,
+    networkTxBytes: 0,
+    blockReadBytes: 0,
+    blockWriteBytes: 0,
+  }
+}
+
+export const ContainerStats = {
+  fromJSON(object: any): ContainerStats {
+    return {
+      container: isSet(object.container) ? ContainerIdentifier.fromJSON(object.container) : undefined,
+      cpuPercent: isSet(object.cpuPercent) ? Number(object.cpuPercent) : 0,
+      memoryUsage: isSet(object.memoryUsage) ? Number(object.memoryUsage) : 0,
+      memoryLimit: isSet(object.memoryLimit) ? Number(object.memoryLimit) : 0,
+      networkRxBytes: isSet(object.networkRxBytes) ? Number(object.networkRxBytes) : 0,
+      networkTxBytes: isSet(object.networkTxBytes) ? Number(object.networkTxBytes) : 0,
+      blockReadBytes: isSet(object.blockReadBytes) ? Number(object.blockReadBytes) : 0,
+      blockWriteBytes: isSet(object.blockWriteBytes) ? Number(object.blockWriteBytes) : 0,
+    }
+  },
+
+  toJSON(message: ContainerStats): unknown {
+    const obj: any = {}
+    message.container !== undefined &&
+      (obj.container = message.container ? ContainerIdentifier.toJSON(message.container) : undefined)
+    message.cpuPercent !== undefined && (obj.cpuPercent = message.cpuPercent)
+    message.memoryUsage !== undefined && (obj.memoryUsage = Math.round(message.memoryUsage))
+    message.memoryLimit !== undefined && (obj.memoryLimit = Math.round(message.memoryLimit))
+    message.networkRxBytes !== undefined && (obj.networkRxBytes = Math.round(message.networkRxBytes))
+    message.networkTxBytes !== undefined && (obj.networkTxBytes = Math.round(message.networkTxBytes))
+    message.blockReadBytes !== undefined && (obj.blockReadBytes = Math.round(message.blockReadBytes))
+    message.blockWriteBytes !== undefined && (obj.blockWriteBytes = Math.round(message.blockWriteBytes))
+    return obj
+  },
+}
+
+function createBaseContainerStatsMessage(): ContainerStatsMessage {
+  return { timestamp: undefined, stats: [] }
+}
+
+export const ContainerStatsMessage = {
+  fromJSON(object: any): ContainerStatsMessage {
+    return {
+      timestamp: isSet(object.timestamp) ? fromJsonTimestamp(object.timestamp) : undefined,
+      stats: Array.isArray(object?.stats) ? object.stats.map((e: any) => ContainerStats.fromJSON(e)) : [],
+    }
+  },
+
+  toJSON(message: ContainerStatsMessage): unknown {
+    const obj: any = {}
+    message.timestamp !== undefined && (obj.timestamp = fromTimestamp(message.timestamp).toISOString())
+    if (message.stats) {
+      obj.stats = message.stats.map(e => (e ? ContainerStats.toJSON(e) : undefined))
+    } else {
+      obj.stats = []
+    }
+    return obj
+  },
+}
+
+function createBaseContainerFileListRequest(): ContainerFileListRequest {
+  return { id: '', container: undefined, path: '' }
+}
+
+export const ContainerFileListRequest = {
+  fromJSON(object: any): ContainerFileListRequest {
+    return {
+      id: isSet(object.id) ? String(object.id) : '',
+      container: isSet(object.container) ? ContainerIdentifier.fromJSON(object.container) : undefined,
+      path: isSet(object.path) ? String(object.path) : '',
+    }
+  },
+
+  toJSON(message: ContainerFileListRequest): unknown {
+    const obj: any = {}
+    message.id !== undefined && (obj.id = message.id)
+    message.container !== undefined &&
+      (obj.container = message.container ? ContainerIdentifier.toJSON(message.container) : undefined)
+    message.path !== undefined && (obj.path = message.path)
+    return obj
+  },
+}
+
+function createBaseContainerFileEntry(): ContainerFileEntry {
+  return { name: '', type: 0, size: 0, mode: 0, modifiedAt: undefined }
+}
+
+export const ContainerFileEntry = {
+  fromJSON(object: any): ContainerFileEntry {
+    return {
+      name: isSet(object.name) ? String(object.name) : '',
+      type: isSet(object.type) ? fileTypeFromJSON(object.type) : 0,
+      size: isSet(object.size) ? Number(object.size) : 0,
+      mode: isSet(object.mode) ? Number(object.mode) : 0,
+      modifiedAt: isSet(object.modifiedAt) ? fromJsonTimestamp(object.modifiedAt) : undefined,
+    }
+  },
+
+  toJSON(message: ContainerFileEntry): unknown {
+    const obj: any = {}
+    message.name !== undefined && (obj.name = message.name)
+    message.type !== undefined && (obj.type = fileTypeToJSON(message.type))
+    message.size !== undefined && (obj.size = Math.round(message.size))
+    message.mode !== undefined && (obj.mode = Math.round(message.mode))
+    message.modifiedAt !== undefined && (obj.modifiedAt = fromTimestamp(message.modifiedAt).toISOString())
+    return obj
+  },
+}
+
+function createBaseContainerFileListResponse(): ContainerFileListResponse {
+  return { id: '', entries: [] }
+}
+
+export const ContainerFileListResponse = {
+  fromJSON(object: any): ContainerFileListResponse {
+    return {
+      id: isSet(object.id) ? String(object.id) : '',
+      error: isSet(object.error) ? String(object.error) : undefined,
+      entries: Array.isArray(object?.entries) ? object.entries.map((e: any) => ContainerFileEntry.fromJSON(e)) : [],
+    }
+  },
+
+  toJSON(message: ContainerFileListResponse): unknown {
+    const obj: any = {}
+    message.id !== undefined && (obj.id = message.id)
+    message.error !== undefined && (obj.error = message.error)
+    if (message.entries) {
+      obj.entries = message.entries.map(e => (e ? ContainerFileEntry.toJSON(e) : undefined))
+    } else {
+      obj.entries = []
+    }
+    return obj
+  },
+}
+
+function createBaseContainerFileDownloadRequest(): ContainerFileDownloadRequest {
+  return { id: '', container: undefined, path: '' }
+}
+
+export const ContainerFileDownloadRequest = {
+  fromJSON(object: any): ContainerFileDownloadRequest {
+    return {
+      id: isSet(object.id) ? String(object.id) : '',
+      container: isSet(object.container) ? ContainerIdentifier.fromJSON(object.container) : undefined,
+      path: isSet(object.path) ? String(object.path) : '',
+    }
+  },
+
+  toJSON(message: ContainerFileDownloadRequest): unknown {
+    const obj: any = {}
+    message.id !== undefined && (obj.id = message.id)
+    message.container !== undefined &&
+      (obj.container = message.container ? ContainerIdentifier.toJSON(message.container) : undefined)
+    message.path !== undefined && (obj.path = message.path)
+    return obj
+  },
+}
+
+function createBaseContainerFileUploadRequest(): ContainerFileUploadRequest {
+  return { id: '', container: undefined, path: '', fileName: '', uid: 0, gid: 0, mode: 0, size: 0 }
+}
+
+export const ContainerFileUploadRequest = {
+  fromJSON(object: any): ContainerFileUploadRequest {
+    return {
+      id: isSet(object.id) ? String(object.id) : '',
+      container: isSet(object.container) ? ContainerIdentifier.fromJSON(object.container) : undefined,
+      path: isSet(object.path) ? String(object.path) : '',
+      fileName: isSet(object.fileName) ? String(object.fileName) : '',
+      uid: isSet(object.uid) ? Number(object.uid) : 0,
+      gid: isSet(object.gid) ? Number(object.gid) : 0,
+      mode: isSet(object.mode) ? Number(object.mode) : 0,
+      size: isSet(object.size) ? Number(object.size) : 0,
+    }
+  },
+
+  toJSON(message: ContainerFileUploadRequest): unknown {
+    const obj: any = {}
+    message.id !== undefined && (obj.id = message.id)
+    message.container !== undefined &&
+      (obj.container = message.container ? ContainerIdentifier.toJSON(message.container) : undefined)
+    message.path !== undefined && (obj.path = message.path)
+    message.fileName !== undefined && (obj.fileName = message.fileName)
+    message.uid !== undefined && (obj.uid = Math.round(message.uid))
+    message.gid !== undefined && (obj.gid = Math.round(message.gid))
+    message.mode !== undefined && (obj.mode = Math.round(message.mode))
+    message.size !== undefined && (obj.size = Math.round(message.size))
+    return obj
+  },
+}
+
+function createBaseContainerFileRequest(): ContainerFileRequest {
+  return { id: '' }
+}
+
+export const ContainerFileRequest = {
+  fromJSON(object: any): ContainerFileRequest {
+    return { id: isSet(object.id) ? String(object.id) : '' }
+  },
+
+  toJSON(message: ContainerFileRequest): unknown {
+    const obj: any = {}
+    message.id !== undefined && (obj.id = message.id)
+    return obj
+  },
+}
+
+function createBaseContainerFileChunk(): ContainerFileChunk {
+  return { data: new Uint8Array() }
+}
+
+export const ContainerFileChunk = {
+  fromJSON(object: any): ContainerFileChunk {
+    return { data: isSet(object.data) ? bytesFromBase64(object.data) : new Uint8Array() }
+  },
+
+  toJSON(message: ContainerFileChunk): unknown {
+    const obj: any = {}
+    message.data !== undefined &&
+      (obj.data = base64FromBytes(message.data !== undefined ? message.data : new Uint8Array()))
+    return obj
+  },
+}
+
+function createBaseImagePruneRequest(): ImagePruneRequest {
+  return { id: '', dryRun: false }
+}
+
+export const ImagePruneRequest = {
+  fromJSON(object: any): ImagePruneRequest {
+    return {
+      id: isSet(object.id) ? String(object.id) : '',
+      dryRun: isSet(object.dryRun) ? Boolean(object.dryRun) : false,
+      keepTags: isSet(object.keepTags) ? Number(object.keepTags) : undefined,
+      minAge: isSet(object.minAge) ? Duration.fromJSON(object.minAge) : undefined,
+    }
+  },
+
+  toJSON(message: ImagePruneRequest): unknown {
+    const obj: any = {}
+    message.id !== undefined && (obj.id = message.id)
+    message.dryRun !== undefined && (obj.dryRun = message.dryRun)
+    message.keepTags !== undefined && (obj.keepTags = Math.round(message.keepTags))
+    message.minAge !== undefined && (obj.minAge = message.minAge ? Duration.toJSON(message.minAge) : undefined)
+    return obj
+  },
+}
+
+function createBasePrunedImage(): PrunedImage {
+  return { id: '', size: 0, createdAt: undefined, repoTags: [] }
+}
+
+export const PrunedImage = {
+  fromJSON(object: any): PrunedImage {
+    return {
+      id: isSet(object.id) ? String(object.id) : '',
+      size: isSet(object.size) ? Number(object.size) : 0,
+      createdAt: isSet(object.createdAt) ? fromJsonTimestamp(object.createdAt) : undefined,
+      repoTags: Array.isArray(object?.repoTags) ? object.repoTags.map((e: any) => String(e)) : [],
+    }
+  },
+
+  toJSON(message: PrunedImage): unknown {
+    const obj: any = {}
+    message.id !== undefined && (obj.id = message.id)
+    message.size !== undefined && (obj.size = Math.round(message.size))
+    message.createdAt !== undefined && (obj.createdAt = fromTimestamp(message.createdAt).toISOString())
+    if (message.repoTags) {
+      obj.repoTags = message.repoTags.map(e => e)
+    } else {
+      obj.repoTags = []
+    }
+    return obj
+  },
+}
+
+function createBaseImagePruneResponse(): ImagePruneResponse {
+  return { id: '', dryRun: false, reclaimedBytes: 0, images: [] }
+}
+
+export const ImagePruneResponse = {
+  fromJSON(object: any): ImagePruneResponse {
+    return {
+      id: isSet(object.id) ? String(object.id) : '',
+      dryRun: isSet(object.dryRun) ? Boolean(object.dryRun) : false,
+      reclaimedBytes: isSet(object.reclaimedBytes) ? Number(object.reclaimedBytes) : 0,
+      error: isSet(object.error) ? String(object.error) : undefined,
+      images: Array.isArray(object?.images) ? object.images.map((e: any) => PrunedImage.fromJSON(e)) : [],
+    }
+  },
+
+  toJSON(message: ImagePruneResponse): unknown {
+    const obj: any = {}
+    message.id !== undefined && (obj.id = message.id)
+    message.dryRun !== undefined && (obj.dryRun = message.dryRun)
+    message.reclaimedBytes !== undefined && (obj.reclaimedBytes = Math.round(message.reclaimedBytes))
+    message.error !== undefined && (obj.error = message.error)
+    if (message.images) {
+      obj.images = message.images.map(e => (e ? PrunedImage.toJSON(e) : undefined))
+    } else {
+      obj.images = []
+    }
+    return obj
+  },
+}
+
+function createBaseDiskUsageRequest(): DiskUsageRequest {
+  return { id: '' }
+}
+
+export const DiskUsageRequest = {
+  fromJSON(object: any): DiskUsageRequest {
+    return {
+      id: isSet(object.id) ? String(object.id) : '',
+      prefix: isSet(object.prefix) ? String(object.prefix) : undefined,
+    }
+  },
+
+  toJSON(message: DiskUsageRequest): unknown {
+    const obj: any = {}
+    message.id !== undefined && (obj.id = message.id)
+    message.prefix !== undefined && (obj.prefix = message.prefix)
+    return obj
+  },
+}
+
+function createBasePrefixDiskUsage(): PrefixDiskUsage {
+  return { prefix: '', containers: 0, containersSize: 0, imagesSize: 0, volumesSize: 0, mountsSize: 0 }
+}
+
+export const PrefixDiskUsage = {
+  fromJSON(object: any): PrefixDiskUsage {
+    return {
+      prefix: isSet(object.prefix) ? String(object.prefix) : '',
+      containers: isSet(object.containers) ? Number(object.containers) : 0,
+      containersSize: isSet(object.containersSize) ? Number(object.containersSize) : 0,
+      imagesSize: isSet(object.imagesSize) ? Number(object.imagesSize) : 0,
+      volumesSize: isSet(object.volumesSize) ? Number(object.volumesSize) : 0,
+      mountsSize: isSet(object.mountsSize) ? Number(object.mountsSize) : 0,
+    }
+  },
+
+  toJSON(message: PrefixDiskUsage): unknown {
+    const obj: any = {}
+    message.prefix !== undefined && (obj.prefix = message.prefix)
+    message.containers !== undefined && (obj.containers = Math.round(message.containers))
+    message.containersSize !== undefined && (obj.containersSize = Math.round(message.containersSize))
+    message.imagesSize !== undefined && (obj.imagesSize = Math.round(message.imagesSize))
+    message.volumesSize !== undefined && (obj.volumesSize = Math.round(message.volumesSize))
+    message.mountsSize !== undefined && (obj.mountsSize = Math.round(message.mountsSize))
+    return obj
+  },
+}
+
+function createBaseDiskUsageResponse(): DiskUsageResponse {
+  return { id: '', imagesSize: 0, reclaimableImagesSize: 0, buildCacheSize: 0, prefixes: [] }
+}
+
+export const DiskUsageResponse = {
+  fromJSON(object: any): DiskUsageResponse {
+    return {
+      id: isSet(object.id) ? String(object.id) : '',
+      imagesSize: isSet(object.imagesSize) ? Number(object.imagesSize) : 0,
+      reclaimableImagesSize: isSet(object.reclaimableImagesSize) ? Number(object.reclaimableImagesSize) : 0,
+      buildCacheSize: isSet(object.buildCacheSize) ? Number(object.buildCacheSize) : 0,
+      error: isSet(object.error) ? String(object.error) : undefined,
+      prefixes: Array.isArray(object?.prefixes) ? object.prefixes.map((e: any) => PrefixDiskUsage.fromJSON(e)) : [],
+    }
+  },
+
+  toJSON(message: DiskUsageResponse): unknown {
+    const obj: any = {}
+    message.id !== undefined && (obj.id = message.id)
+    message.imagesSize !== undefined && (obj.imagesSize = Math.round(message.imagesSize))
+    message.reclaimableImagesSize !== undefined &&
+      (obj.reclaimableImagesSize = Math.round(message.reclaimableImagesSize))
+    message.buildCacheSize !== undefined && (obj.buildCacheSize = Math.round(message.buildCacheSize))
+    message.error !== undefined && (obj.error = message.error)
+    if (message.prefixes) {
+      obj.prefixes = message.prefixes.map(e => (e ? PrefixDiskUsage.toJSON(e) : undefined))
+    } else {
+      obj.prefixes = []
+    }
+    return obj
+  },
+}
+
+/** Service handling deployment of containers and fetching statuses */
+
+export interface AgentClient {
+  /**
+   * Subscribe with pre-assigned AgentID, waiting for incoming
+   * deploy requests and prefix status requests.
+   * In both cases, separate, shorter-living channels are opened.
+   * For deployment status reports, closed when ended.
+   * For prefix state reports, should be closed by the server.
+   */
 
   connect(request: AgentInfo, metadata: Metadata, ...rest: any): Observable<AgentCommand>
 
@@ -1410,6 +2807,63 @@ export interface AgentClient {
   deleteContainers(request: DeleteContainersRequest, metadata: Metadata, ...rest: any): Observable<Empty>
 
   containerLog(request: Observable<ContainerLogMessage>, metadata: Metadata, ...rest: any): Observable<Empty>
+
+  /**
+   * Reports the outcome of an AgentCommand, correlated by its commandId.
+   * Sent only for commands with a non-empty commandId.
+   */
+
+  commandResult(request: CommandResultRequest, metadata: Metadata, ...rest: any): Observable<Empty>
+
+  /**
+   * Volume backup and restore, correlated by the dyo-volume-operation-id
+   * metadata. The archive is uploaded by VolumeBackupArchive and downloaded
+   * by VolumeRestoreArchive, unless an rclone remote is used.
+   */
+
+  volumeOperationStatus(
+    request: Observable<VolumeOperationStatusMessage>,
+    metadata: Metadata,
+    ...rest: any
+  ): Observable<Empty>
+
+  volumeBackupArchive(request: Observable<VolumeArchiveChunk>, metadata: Metadata, ...rest: any): Observable<Empty>
+
+  volumeRestoreArchive(request: VolumeArchiveRequest, metadata: Metadata, ...rest: any): Observable<VolumeArchiveChunk>
+
+  /**
+   * Interactive exec session, correlated by the dyo-container-exec-id
+   * metadata. The agent sends the output, crux sends the input and the
+   * terminal size.
+   */
+
+  containerExec(
+    request: Observable<ContainerExecOutput>,
+    metadata: Metadata,
+    ...rest: any
+  ): Observable<ContainerExecInput>
+
+  /**
+   * Resource usage samples, correlated by the dyo-container-prefix and
+   * dyo-container-name metadata like ContainerLog, closed by the server.
+   */
+
+  containerStats(request: Observable<ContainerStatsMessage>, metadata: Metadata, ...rest: any): Observable<Empty>
+
+  /**
+   * Container file browser, the downloaded and uploaded tar archives are
+   * correlated by the dyo-container-file-id metadata.
+   */
+
+  containerFileList(request: ContainerFileListResponse, metadata: Metadata, ...rest: any): Observable<Empty>
+
+  containerFileDownload(request: Observable<ContainerFileChunk>, metadata: Metadata, ...rest: any): Observable<Empty>
+
+  containerFileUpload(request: ContainerFileRequest, metadata: Metadata, ...rest: any): Observable<ContainerFileChunk>
+
+  imagePruneResult(request: ImagePruneResponse, metadata: Metadata, ...rest: any): Observable<Empty>
+
+  diskUsage(request: DiskUsageResponse, metadata: Metadata, ...rest: any): Observable<Empty>
 }
 
 /** Service handling deployment of containers and fetching statuses */
@@ -1452,16 +2906,117 @@ export interface AgentController {
     metadata: Metadata,
     ...rest: any
   ): Promise<Empty> | Observable<Empty> | Empty
+
+  /**
+   * Reports the outcome of an AgentCommand, correlated by its commandId.
+   * Sent only for commands with a non-empty commandId.
+   */
+
+  commandResult(
+    request: CommandResultRequest,
+    metadata: Metadata,
+    ...rest: any
+  ): Promise<Empty> | Observable<Empty> | Empty
+
+  /**
+   * Volume backup and restore, correlated by the dyo-volume-operation-id
+   * metadata. The archive is uploaded by VolumeBackupArchive and downloaded
+   * by VolumeRestoreArchive, unless an rclone remote is used.
+   */
+
+  volumeOperationStatus(
+    request: Observable<VolumeOperationStatusMessage>,
+    metadata: Metadata,
+    ...rest: any
+  ): Promise<Empty> | Observable<Empty> | Empty
+
+  volumeBackupArchive(
+    request: Observable<VolumeArchiveChunk>,
+    metadata: Metadata,
+    ...rest: any
+  ): Promise<Empty> | Observable<Empty> | Empty
+
+  volumeRestoreArchive(request: VolumeArchiveRequest, metadata: Metadata, ...rest: any): Observable<VolumeArchiveChunk>
+
+  /**
+   * Interactive exec session, correlated by the dyo-container-exec-id
+   * metadata. The agent sends the output, crux sends the input and the
+   * terminal size.
+   */
+
+  containerExec(
+    request: Observable<ContainerExecOutput>,
+    metadata: Metadata,
+    ...rest: any
+  ): Observable<ContainerExecInput>
+
+  /**
+   * Resource usage samples, correlated by the dyo-container-prefix and
+   * dyo-container-name metadata like ContainerLog, closed by the server.
+   */
+
+  containerStats(
+    request: Observable<ContainerStatsMessage>,
+    metadata: Metadata,
+    ...rest: any
+  ): Promise<Empty> | Observable<Empty> | Empty
+
+  /**
+   * Container file browser, the downloaded and uploaded tar archives are
+   * correlated by the dyo-container-file-id metadata.
+   */
+
+  containerFileList(
+    request: ContainerFileListResponse,
+    metadata: Metadata,
+    ...rest: any
+  ): Promise<Empty> | Observable<Empty> | Empty
+
+  containerFileDownload(
+    request: Observable<ContainerFileChunk>,
+    metadata: Metadata,
+    ...rest: any
+  ): Promise<Empty> | Observable<Empty> | Empty
+
+  containerFileUpload(request: ContainerFileRequest, metadata: Metadata, ...rest: any): Observable<ContainerFileChunk>
+
+  imagePruneResult(
+    request: ImagePruneResponse,
+    metadata: Metadata,
+    ...rest: any
+  ): Promise<Empty> | Observable<Empty> | Empty
+
+  diskUsage(request: DiskUsageResponse, metadata: Metadata, ...rest: any): Promise<Empty> | Observable<Empty> | Empty
 }
 
 export function AgentControllerMethods() {
   return function (constructor: Function) {
-    const grpcMethods: string[] = ['connect', 'secretList', 'abortUpdate', 'deleteContainers']
+    const grpcMethods: string[] = [
+      'connect',
+      'secretList',
+      'abortUpdate',
+      'deleteContainers',
+      'commandResult',
+      'volumeRestoreArchive',
+      'containerFileList',
+      'containerFileUpload',
+      'imagePruneResult',
+      'diskUsage',
+    ]
     for (const method of grpcMethods) {
       const descriptor: any = Reflect.getOwnPropertyDescriptor(constructor.prototype, method)
       GrpcMethod('Agent', method)(constructor.prototype[method], method, descriptor)
     }
-    const grpcStreamMethods: string[] = ['deploymentStatus', 'containerState', 'containerLog']
+    const grpcStreamMethods: string[] = [
+      'deploymentStatus',
+      'containerState',
+      'containerLog',
+      'volumeOperationStatus',
+      'volumeBackupArchive',
+      'containerExec',
+      'containerStats',
+      'containerFileDownload',
+    ]
     for (const method of grpcStreamMethods) {
       const descriptor: any = Reflect.getOwnPropertyDescriptor(constructor.prototype, method)
       GrpcStreamMethod('Agent', method)(constructor.prototype[method], method, descriptor)
@@ -1471,6 +3026,72 @@ export function AgentControllerMethods() {
 
 export const AGENT_SERVICE_NAME = 'Agent'
 
+declare var self: any | undefined
+declare var window: any | undefined
+declare var global: any | undefined
+var tsProtoGlobalThis: any = (() => {
+  if (typeof globalThis !== 'undefined') {
+    return globalThis
+  }
+  if (typeof self !== 'undefined') {
+    return self
+  }
+  if (typeof window !== 'undefined') {
+    return window
+  }
+  if (typeof global !== 'undefined') {
+    return global
+  }
+  throw 'Unable to locate global object'
+})()
+
+function bytesFromBase64(b64: string): Uint8Array {
+  if (tsProtoGlobalThis.Buffer) {
+    return Uint8Array.from(tsProtoGlobalThis.Buffer.from(b64, 'base64'))
+  } else {
+    const bin = tsProtoGlobalThis.atob(b64)
+    const arr = new Uint8Array(bin.length)
+    for (let i = 0; i < bin.length; ++i) {
+      arr[i] = bin.charCodeAt(i)
+    }
+    return arr
+  }
+}
+
+function base64FromBytes(arr: Uint8Array): string {
+  if (tsProtoGlobalThis.Buffer) {
+    return tsProtoGlobalThis.Buffer.from(arr).toString('base64')
+  } else {
+    const bin: string[] = []
+    arr.forEach(byte => {
+      bin.push(String.fromCharCode(byte))
+    })
+    return tsProtoGlobalThis.btoa(bin.join(''))
+  }
+}
+
+function toTimestamp(date: Date): Timestamp {
+  const seconds = date.getTime() / 1_000
+  const nanos = (date.getTime() % 1_000) * 1_000_000
+  return { seconds, nanos }
+}
+
+function fromTimestamp(t: Timestamp): Date {
+  let millis = t.seconds * 1_000
+  millis += t.nanos / 1_000_000
+  return new Date(millis)
+}
+
+function fromJsonTimestamp(o: any): Timestamp {
+  if (o instanceof Date) {
+    return toTimestamp(o)
+  } else if (typeof o === 'string') {
+    return toTimestamp(new Date(o))
+  } else {
+    return Timestamp.fromJSON(o)
+  }
+}
+
 function isObject(value: any): boolean {
   return typeof value === 'object' && value !== null
 }
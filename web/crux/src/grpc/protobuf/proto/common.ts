@@ -260,6 +260,8 @@ export enum DeploymentStrategy {
   DEPLOYMENT_STRATEGY_UNSPECIFIED = 0,
   RECREATE = 1,
   ROLLING = 2,
+  /** BLUE_GREEN - dagent only: the new container replaces the old one once healthy */
+  BLUE_GREEN = 3,
   UNRECOGNIZED = -1,
 }
 
@@ -274,6 +276,9 @@ export function deploymentStrategyFromJSON(object: any): DeploymentStrategy {
     case 2:
     case 'ROLLING':
       return DeploymentStrategy.ROLLING
+    case 3:
+    case 'BLUE_GREEN':
+      return DeploymentStrategy.BLUE_GREEN
     case -1:
     case 'UNRECOGNIZED':
     default:
@@ -289,12 +294,60 @@ export function deploymentStrategyToJSON(object: DeploymentStrategy): string {
       return 'RECREATE'
     case DeploymentStrategy.ROLLING:
       return 'ROLLING'
+    case DeploymentStrategy.BLUE_GREEN:
+      return 'BLUE_GREEN'
     case DeploymentStrategy.UNRECOGNIZED:
     default:
       return 'UNRECOGNIZED'
   }
 }
 
+/** When the image of a container is pulled, the default is IF_NOT_PRESENT on dagent and the Kubernetes default on crane */
+export enum PullPolicy {
+  PULL_POLICY_UNSPECIFIED = 0,
+  PULL_POLICY_ALWAYS = 1,
+  PULL_POLICY_IF_NOT_PRESENT = 2,
+  PULL_POLICY_NEVER = 3,
+  UNRECOGNIZED = -1,
+}
+
+export function pullPolicyFromJSON(object: any): PullPolicy {
+  switch (object) {
+    case 0:
+    case 'PULL_POLICY_UNSPECIFIED':
+      return PullPolicy.PULL_POLICY_UNSPECIFIED
+    case 1:
+    case 'PULL_POLICY_ALWAYS':
+      return PullPolicy.PULL_POLICY_ALWAYS
+    case 2:
+    case 'PULL_POLICY_IF_NOT_PRESENT':
+      return PullPolicy.PULL_POLICY_IF_NOT_PRESENT
+    case 3:
+    case 'PULL_POLICY_NEVER':
+      return PullPolicy.PULL_POLICY_NEVER
+    case -1:
+    case 'UNRECOGNIZED':
+    default:
+      return PullPolicy.UNRECOGNIZED
+  }
+}
+
+export function pullPolicyToJSON(object: PullPolicy): string {
+  switch (object) {
+    case PullPolicy.PULL_POLICY_UNSPECIFIED:
+      return 'PULL_POLICY_UNSPECIFIED'
+    case PullPolicy.PULL_POLICY_ALWAYS:
+      return 'PULL_POLICY_ALWAYS'
+    case PullPolicy.PULL_POLICY_IF_NOT_PRESENT:
+      return 'PULL_POLICY_IF_NOT_PRESENT'
+    case PullPolicy.PULL_POLICY_NEVER:
+      return 'PULL_POLICY_NEVER'
+    case PullPolicy.UNRECOGNIZED:
+    default:
+      return 'UNRECOGNIZED'
+  }
+}
+
 export enum VolumeType {
   VOLUME_TYPE_UNSPECIFIED = 0,
   RO = 1,
@@ -561,7 +614,11 @@ export interface ContainerStateItemPort {
 
 export interface ContainerStateListMessage {
   prefix?: string | undefined
+  /** When set, data only holds the added and changed containers, otherwise it is the full list */
+  partial: boolean
   data: ContainerStateItem[]
+  /** Containers removed since the previous message, only used when partial is set */
+  removed: ContainerIdentifier[]
 }
 
 export interface ContainerStateItem {
@@ -578,6 +635,8 @@ export interface ContainerStateItem {
   status: string
   imageName: string
   imageTag: string
+  /** The repository digest of the image (sha256:...) if it is known */
+  imageDigest?: string | undefined
   ports: ContainerStateItemPort[]
 }
 
@@ -735,25 +794,33 @@ export const ContainerStateItemPort = {
 }
 
 function createBaseContainerStateListMessage(): ContainerStateListMessage {
-  return { data: [] }
+  return { partial: false, data: [], removed: [] }
 }
 
 export const ContainerStateListMessage = {
   fromJSON(object: any): ContainerStateListMessage {
     return {
       prefix: isSet(object.prefix) ? String(object.prefix) : undefined,
+      partial: isSet(object.partial) ? Boolean(object.partial) : false,
       data: Array.isArray(object?.data) ? object.data.map((e: any) => ContainerStateItem.fromJSON(e)) : [],
+      removed: Array.isArray(object?.removed) ? object.removed.map((e: any) => ContainerIdentifier.fromJSON(e)) : [],
     }
   },
 
   toJSON(message: ContainerStateListMessage): unknown {
     const obj: any = {}
     message.prefix !== undefined && (obj.prefix = message.prefix)
+    message.partial !== undefined && (obj.partial = message.partial)
     if (message.data) {
       obj.data = message.data.map(e => (e ? ContainerStateItem.toJSON(e) : undefined))
     } else {
       obj.data = []
     }
+    if (message.removed) {
+      obj.removed = message.removed.map(e => (e ? ContainerIdentifier.toJSON(e) : undefined))
+    } else {
+      obj.removed = []
+    }
     return obj
   },
 }
@@ -781,6 +848,7 @@ export const ContainerStateItem = {
       status: isSet(object.status) ? String(object.status) : '',
       imageName: isSet(object.imageName) ? String(object.imageName) : '',
       imageTag: isSet(object.imageTag) ? String(object.imageTag) : '',
+      imageDigest: isSet(object.imageDigest) ? String(object.imageDigest) : undefined,
       ports: Array.isArray(object?.ports) ? object.ports.map((e: any) => ContainerStateItemPort.fromJSON(e)) : [],
     }
   },
@@ -794,6 +862,7 @@ export const ContainerStateItem = {
     message.status !== undefined && (obj.status = message.status)
     message.imageName !== undefined && (obj.imageName = message.imageName)
     message.imageTag !== undefined && (obj.imageTag = message.imageTag)
+    message.imageDigest !== undefined && (obj.imageDigest = message.imageDigest)
     if (message.ports) {
       obj.ports = message.ports.map(e => (e ? ContainerStateItemPort.toJSON(e) : undefined))
     } else {
@@ -18,6 +18,12 @@ export default class GrpcNodeConnection {
 
   public static META_CONTAINER_NAME = 'dyo-container-name'
 
+  public static META_VOLUME_OPERATION_ID = 'dyo-volume-operation-id'
+
+  public static META_CONTAINER_EXEC_ID = 'dyo-container-exec-id'
+
+  public static META_CONTAINER_FILE_ID = 'dyo-container-file-id'
+
   private statusChannel = new Subject<NodeConnectionStatus>()
 
   private token: AgentToken